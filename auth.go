@@ -0,0 +1,148 @@
+package gotmdbapi
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// AuthAuto detects the authentication mode of each credential by its shape
+	AuthAuto AuthMode = iota
+
+	// AuthBearer sends the credential as a TMDB API v4 read access token in the Authorization header
+	AuthBearer
+
+	// AuthAPIKey sends the credential as a TMDB API v3 key in the api_key query parameter
+	AuthAPIKey
+)
+
+const (
+	// v3APIKeyLength is the length of the TMDB API v3 keys, which are hexadecimal strings
+	v3APIKeyLength = 32
+)
+
+type (
+	// AuthMode is how the credentials are sent to the TMDB API
+	AuthMode int
+)
+
+// DetectAuthMode detects the authentication mode of a credential by its shape, where the TMDB API v3 keys are 32
+// hexadecimal characters and anything else, such as the JWT of the v4 read access tokens, is sent as a bearer token
+//
+// Parameters:
+//
+// - credential: the TMDB API v3 key or v4 read access token
+//
+// Returns:
+//
+// - AuthMode: AuthAPIKey for the v3 keys, AuthBearer otherwise
+func DetectAuthMode(credential string) AuthMode {
+	if len(credential) != v3APIKeyLength {
+		return AuthBearer
+	}
+	for _, r := range credential {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return AuthBearer
+		}
+	}
+	return AuthAPIKey
+}
+
+// WithAuthMode creates a derived client that sends the credentials with the given authentication mode
+//
+// Parameters:
+//
+// - mode: the authentication mode, where AuthAuto detects it by the shape of each credential
+//
+// Returns:
+//
+// - *Client: the derived client
+func (c Client) WithAuthMode(mode AuthMode) *Client {
+	c.authMode = mode
+	return &c
+}
+
+// CacheKey returns a key identifying the response of a request, such as for caching middlewares, without the API
+// key, so the cached responses are shared by the credentials and never store them
+//
+// The session IDs are kept, since the responses of the session requests are specific to each user.
+//
+// Parameters:
+//
+// - req: the HTTP request
+//
+// Returns:
+//
+// - string: the cache key, made of the method and the URL without the API key
+func CacheKey(req *http.Request) string {
+	u := *req.URL
+	q := u.Query()
+	q.Del(APIKey)
+	u.RawQuery = q.Encode()
+	return req.Method + " " + u.String()
+}
+
+// RedactURL returns the URL replacing the credentials of its query parameters with RedactedValue
+//
+// Parameters:
+//
+// - u: the URL
+//
+// Returns:
+//
+// - string: the redacted URL
+func RedactURL(u *url.URL) string {
+	redacted := *u
+	redacted.RawQuery = RedactQuery(u.Query())
+	return redacted.String()
+}
+
+// redactError redacts the credentials from the URL of the transport errors, which include the request URL
+//
+// Parameters:
+//
+// - err: the error of the request
+func redactError(err error) {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return
+	}
+	if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+		urlErr.URL = RedactURL(u)
+	}
+}
+
+// resolveAuthMode returns the authentication mode of a credential, detecting it by its shape for AuthAuto
+//
+// Parameters:
+//
+// - credential: the TMDB API v3 key or v4 read access token
+//
+// Returns:
+//
+// - AuthMode: either AuthBearer or AuthAPIKey
+func (c Client) resolveAuthMode(credential string) AuthMode {
+	if c.authMode == AuthAuto {
+		return DetectAuthMode(credential)
+	}
+	return c.authMode
+}
+
+// setCredential sets the credential of the HTTP request, following the authentication mode
+//
+// Parameters:
+//
+// - req: the HTTP request
+// - credential: the TMDB API v3 key or v4 read access token
+// - mode: the resolved authentication mode
+func setCredential(req *http.Request, credential string, mode AuthMode) {
+	if mode == AuthAPIKey {
+		q := req.URL.Query()
+		q.Set(APIKey, credential)
+		req.URL.RawQuery = q.Encode()
+		return
+	}
+	req.Header.Set("Authorization", "Bearer "+credential)
+}
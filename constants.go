@@ -92,7 +92,7 @@ const (
 	WatchRegion = "watch_region"
 
 	// WithReleaseType is the query parameter for release type
-	// WithReleaseType = "with_release_type" // I don't know what means each value
+	WithReleaseType = "with_release_type"
 
 	// WithRuntimeGTE is the query parameter for runtime greater than or equal to
 	WithRuntimeGTE = "with_runtime.gte"
@@ -117,6 +117,39 @@ const (
 
 	// WithoutWatchProviders is the query parameter for excluding watch providers
 	WithoutWatchProviders = "without_watch_providers"
+
+	// SessionID is the query parameter for the user session ID
+	SessionID = "session_id"
+
+	// GuestSessionID is the query parameter for the guest session ID
+	GuestSessionID = "guest_session_id"
+
+	// APIKey is the query parameter for the TMDB API v3 key
+	APIKey = "api_key"
+
+	// ItemMovieID is the query parameter for the movie ID of a list item
+	ItemMovieID = "movie_id"
+
+	// Confirm is the query parameter for confirming destructive operations
+	Confirm = "confirm"
+
+	// MediaID is the query parameter for the media ID
+	MediaID = "media_id"
+
+	// MediaType is the query parameter for the media type
+	MediaType = "media_type"
+
+	// IncludeImageLanguage is the query parameter for the languages of the images to include
+	IncludeImageLanguage = "include_image_language"
+
+	// IncludeVideoLanguage is the query parameter for the languages of the videos to include
+	IncludeVideoLanguage = "include_video_language"
+
+	// StartDate is the query parameter for the start date of a changes window
+	StartDate = "start_date"
+
+	// EndDate is the query parameter for the end date of a changes window
+	EndDate = "end_date"
 )
 
 const (
@@ -125,6 +158,9 @@ const (
 
 	// ImageVariableQualityURL is the image variable quality URL
 	ImageVariableQualityURL = "https://image.tmdb.org/t/p/w%d/%s"
+
+	// ImageSizedURL is the image URL for a given size
+	ImageSizedURL = "https://image.tmdb.org/t/p/%s/%s"
 )
 
 const (
@@ -134,6 +170,9 @@ const (
 	// GetMovieDetailsURL is the TMDB API URL for getting movie details
 	GetMovieDetailsURL = "https://api.themoviedb.org/3/movie/%s"
 
+	// GetMovieTranslationsURL is the TMDB API URL for getting the translations of a movie
+	GetMovieTranslationsURL = "https://api.themoviedb.org/3/movie/%s/translations"
+
 	// GetMovieReviewsURL is the TMDB API URL for getting movie reviews
 	GetMovieReviewsURL = "https://api.themoviedb.org/3/movie/%s/reviews"
 
@@ -160,4 +199,244 @@ const (
 
 	// GetGenresMovieListURL is the TMDB API URL for getting the list of movie genres
 	GetGenresMovieListURL = "https://api.themoviedb.org/3/genre/movie/list"
+
+	// GetCompanyDetailsURL is the TMDB API URL for getting company details
+	GetCompanyDetailsURL = "https://api.themoviedb.org/3/company/%s"
+
+	// GetCompanyAlternativeNamesURL is the TMDB API URL for getting company alternative names
+	GetCompanyAlternativeNamesURL = "https://api.themoviedb.org/3/company/%s/alternative_names"
+
+	// GetCompanyImagesURL is the TMDB API URL for getting company images
+	GetCompanyImagesURL = "https://api.themoviedb.org/3/company/%s/images"
+
+	// GetNetworkDetailsURL is the TMDB API URL for getting network details
+	GetNetworkDetailsURL = "https://api.themoviedb.org/3/network/%s"
+
+	// GetNetworkImagesURL is the TMDB API URL for getting network images
+	GetNetworkImagesURL = "https://api.themoviedb.org/3/network/%s/images"
+
+	// GetKeywordDetailsURL is the TMDB API URL for getting keyword details
+	GetKeywordDetailsURL = "https://api.themoviedb.org/3/keyword/%s"
+
+	// GetKeywordMoviesURL is the TMDB API URL for getting the movies tagged with a keyword
+	GetKeywordMoviesURL = "https://api.themoviedb.org/3/keyword/%s/movies"
+
+	// GetMovieChangeListURL is the TMDB API URL for getting the list of changed movies
+	GetMovieChangeListURL = "https://api.themoviedb.org/3/movie/changes"
+
+	// GetTVChangeListURL is the TMDB API URL for getting the list of changed TV shows
+	GetTVChangeListURL = "https://api.themoviedb.org/3/tv/changes"
+
+	// GetPersonChangeListURL is the TMDB API URL for getting the list of changed people
+	GetPersonChangeListURL = "https://api.themoviedb.org/3/person/changes"
+
+	// GetMovieChangesURL is the TMDB API URL for getting the changes of a movie
+	GetMovieChangesURL = "https://api.themoviedb.org/3/movie/%s/changes"
+
+	// GetTVChangesURL is the TMDB API URL for getting the changes of a TV show
+	GetTVChangesURL = "https://api.themoviedb.org/3/tv/%s/changes"
+
+	// GetTVSeasonChangesURL is the TMDB API URL for getting the changes of a TV season
+	GetTVSeasonChangesURL = "https://api.themoviedb.org/3/tv/season/%s/changes"
+
+	// GetTVEpisodeChangesURL is the TMDB API URL for getting the changes of a TV episode
+	GetTVEpisodeChangesURL = "https://api.themoviedb.org/3/tv/episode/%s/changes"
+
+	// GetPersonChangesURL is the TMDB API URL for getting the changes of a person
+	GetPersonChangesURL = "https://api.themoviedb.org/3/person/%s/changes"
+
+	// CreateRequestTokenURL is the TMDB API URL for creating a request token
+	CreateRequestTokenURL = "https://api.themoviedb.org/3/authentication/token/new"
+
+	// ValidateRequestTokenWithLoginURL is the TMDB API URL for validating a request token with the user's credentials
+	ValidateRequestTokenWithLoginURL = "https://api.themoviedb.org/3/authentication/token/validate_with_login"
+
+	// CreateSessionURL is the TMDB API URL for creating a session
+	CreateSessionURL = "https://api.themoviedb.org/3/authentication/session/new"
+
+	// CreateSessionFromV4TokenURL is the TMDB API URL for creating a session from a v4 access token
+	CreateSessionFromV4TokenURL = "https://api.themoviedb.org/3/authentication/session/convert/4"
+
+	// CreateGuestSessionURL is the TMDB API URL for creating a guest session
+	CreateGuestSessionURL = "https://api.themoviedb.org/3/authentication/guest_session/new"
+
+	// DeleteSessionURL is the TMDB API URL for deleting a session
+	DeleteSessionURL = "https://api.themoviedb.org/3/authentication/session"
+
+	// GetAccountDetailsURL is the TMDB API URL for getting account details
+	GetAccountDetailsURL = "https://api.themoviedb.org/3/account/%s"
+
+	// AddFavoriteURL is the TMDB API URL for marking an item as favorite
+	AddFavoriteURL = "https://api.themoviedb.org/3/account/%s/favorite"
+
+	// AddToWatchlistURL is the TMDB API URL for adding an item to the watchlist
+	AddToWatchlistURL = "https://api.themoviedb.org/3/account/%s/watchlist"
+
+	// GetFavoriteMoviesURL is the TMDB API URL for getting the favorite movies of an account
+	GetFavoriteMoviesURL = "https://api.themoviedb.org/3/account/%s/favorite/movies"
+
+	// GetFavoriteTVURL is the TMDB API URL for getting the favorite TV shows of an account
+	GetFavoriteTVURL = "https://api.themoviedb.org/3/account/%s/favorite/tv"
+
+	// GetWatchlistMoviesURL is the TMDB API URL for getting the watchlist movies of an account
+	GetWatchlistMoviesURL = "https://api.themoviedb.org/3/account/%s/watchlist/movies"
+
+	// GetWatchlistTVURL is the TMDB API URL for getting the watchlist TV shows of an account
+	GetWatchlistTVURL = "https://api.themoviedb.org/3/account/%s/watchlist/tv"
+
+	// GetRatedMoviesURL is the TMDB API URL for getting the rated movies of an account
+	GetRatedMoviesURL = "https://api.themoviedb.org/3/account/%s/rated/movies"
+
+	// GetRatedTVURL is the TMDB API URL for getting the rated TV shows of an account
+	GetRatedTVURL = "https://api.themoviedb.org/3/account/%s/rated/tv"
+
+	// GetRatedTVEpisodesURL is the TMDB API URL for getting the rated TV episodes of an account
+	GetRatedTVEpisodesURL = "https://api.themoviedb.org/3/account/%s/rated/tv/episodes"
+
+	// GetMovieAccountStatesURL is the TMDB API URL for getting the account states of a movie
+	GetMovieAccountStatesURL = "https://api.themoviedb.org/3/movie/%s/account_states"
+
+	// MovieRatingURL is the TMDB API URL for adding or deleting the rating of a movie
+	MovieRatingURL = "https://api.themoviedb.org/3/movie/%s/rating"
+
+	// TVRatingURL is the TMDB API URL for adding or deleting the rating of a TV show
+	TVRatingURL = "https://api.themoviedb.org/3/tv/%s/rating"
+
+	// TVEpisodeRatingURL is the TMDB API URL for adding or deleting the rating of a TV episode
+	TVEpisodeRatingURL = "https://api.themoviedb.org/3/tv/%s/season/%s/episode/%s/rating"
+
+	// CreateListURL is the TMDB API URL for creating a list
+	CreateListURL = "https://api.themoviedb.org/3/list"
+
+	// ListURL is the TMDB API URL for getting or deleting a list
+	ListURL = "https://api.themoviedb.org/3/list/%s"
+
+	// AddMovieToListURL is the TMDB API URL for adding a movie to a list
+	AddMovieToListURL = "https://api.themoviedb.org/3/list/%s/add_item"
+
+	// RemoveMovieFromListURL is the TMDB API URL for removing a movie from a list
+	RemoveMovieFromListURL = "https://api.themoviedb.org/3/list/%s/remove_item"
+
+	// CheckItemStatusURL is the TMDB API URL for checking if a movie is present in a list
+	CheckItemStatusURL = "https://api.themoviedb.org/3/list/%s/item_status"
+
+	// ClearListURL is the TMDB API URL for clearing all the items of a list
+	ClearListURL = "https://api.themoviedb.org/3/list/%s/clear"
+
+	// GetReviewDetailsURL is the TMDB API URL for getting review details
+	GetReviewDetailsURL = "https://api.themoviedb.org/3/review/%s"
+
+	// GetCreditDetailsURL is the TMDB API URL for getting credit details
+	GetCreditDetailsURL = "https://api.themoviedb.org/3/credit/%s"
+
+	// GetTVEpisodeGroupsURL is the TMDB API URL for getting the episode groups of a TV show
+	GetTVEpisodeGroupsURL = "https://api.themoviedb.org/3/tv/%s/episode_groups"
+
+	// GetTVEpisodeGroupDetailsURL is the TMDB API URL for getting the details of a TV episode group
+	GetTVEpisodeGroupDetailsURL = "https://api.themoviedb.org/3/tv/episode_group/%s"
+
+	// GetTVSeasonAggregateCreditsURL is the TMDB API URL for getting the aggregate credits of a TV season
+	GetTVSeasonAggregateCreditsURL = "https://api.themoviedb.org/3/tv/%s/season/%s/aggregate_credits"
+
+	// GetTVSeasonImagesURL is the TMDB API URL for getting the images of a TV season
+	GetTVSeasonImagesURL = "https://api.themoviedb.org/3/tv/%s/season/%s/images"
+
+	// GetTVSeasonVideosURL is the TMDB API URL for getting the videos of a TV season
+	GetTVSeasonVideosURL = "https://api.themoviedb.org/3/tv/%s/season/%s/videos"
+
+	// GetTVSeasonWatchProvidersURL is the TMDB API URL for getting the watch providers of a TV season
+	GetTVSeasonWatchProvidersURL = "https://api.themoviedb.org/3/tv/%s/season/%s/watch/providers"
+
+	// GetTVSeasonExternalIDsURL is the TMDB API URL for getting the external IDs of a TV season
+	GetTVSeasonExternalIDsURL = "https://api.themoviedb.org/3/tv/%s/season/%s/external_ids"
+)
+
+const (
+	// V4CreateRequestTokenURL is the TMDB API v4 URL for creating a request token
+	V4CreateRequestTokenURL = "https://api.themoviedb.org/4/auth/request_token"
+
+	// V4AccessTokenURL is the TMDB API v4 URL for creating or deleting an access token
+	V4AccessTokenURL = "https://api.themoviedb.org/4/auth/access_token"
+
+	// V4CreateListURL is the TMDB API v4 URL for creating a list
+	V4CreateListURL = "https://api.themoviedb.org/4/list"
+
+	// V4ListURL is the TMDB API v4 URL for getting, updating or deleting a list
+	V4ListURL = "https://api.themoviedb.org/4/list/%s"
+
+	// V4ClearListURL is the TMDB API v4 URL for clearing all the items of a list
+	V4ClearListURL = "https://api.themoviedb.org/4/list/%s/clear"
+
+	// V4ListItemsURL is the TMDB API v4 URL for adding, updating or removing the items of a list
+	V4ListItemsURL = "https://api.themoviedb.org/4/list/%s/items"
+
+	// V4ListItemStatusURL is the TMDB API v4 URL for checking if an item is present in a list
+	V4ListItemStatusURL = "https://api.themoviedb.org/4/list/%s/item_status"
+
+	// V4AccountListsURL is the TMDB API v4 URL for getting the lists of an account
+	V4AccountListsURL = "https://api.themoviedb.org/4/account/%s/lists"
+
+	// V4AccountFavoriteMoviesURL is the TMDB API v4 URL for getting the favorite movies of an account
+	V4AccountFavoriteMoviesURL = "https://api.themoviedb.org/4/account/%s/movie/favorites"
+
+	// V4AccountFavoriteTVURL is the TMDB API v4 URL for getting the favorite TV shows of an account
+	V4AccountFavoriteTVURL = "https://api.themoviedb.org/4/account/%s/tv/favorites"
+
+	// V4AccountMovieRecommendationsURL is the TMDB API v4 URL for getting the movie recommendations of an account
+	V4AccountMovieRecommendationsURL = "https://api.themoviedb.org/4/account/%s/movie/recommendations"
+
+	// V4AccountTVRecommendationsURL is the TMDB API v4 URL for getting the TV show recommendations of an account
+	V4AccountTVRecommendationsURL = "https://api.themoviedb.org/4/account/%s/tv/recommendations"
+)
+
+const (
+	// AndSeparator is the separator used by the TMDB API to combine the values of a filter with a logical AND
+	AndSeparator = ","
+
+	// OrSeparator is the separator used by the TMDB API to combine the values of a filter with a logical OR
+	OrSeparator = "|"
+)
+
+const (
+	// DefaultLanguage is the language used by the TMDB API when no language is requested
+	DefaultLanguage = "en-US"
+
+	// DateLayout is the layout of the dates used by the TMDB API
+	DateLayout = "2006-01-02"
+
+	// MaxChangesWindowDays is the maximum number of days allowed between the start and end dates of a changes request
+	MaxChangesWindowDays = 14
+)
+
+const (
+	// MinRating is the minimum rating value accepted by the TMDB API
+	MinRating float32 = 0.5
+
+	// MaxRating is the maximum rating value accepted by the TMDB API
+	MaxRating float32 = 10.0
+
+	// RatingStep is the step between the rating values accepted by the TMDB API
+	RatingStep float32 = 0.5
+)
+
+const (
+	// MinVoteAverage is the minimum vote average accepted by the TMDB API filters
+	MinVoteAverage float32 = 0
+
+	// MaxVoteAverage is the maximum vote average accepted by the TMDB API filters
+	MaxVoteAverage float32 = 10
+
+	// MaxPage is the maximum page number accepted by the TMDB API
+	MaxPage int32 = 500
+)
+
+const (
+	// StatusCodeSuccess is the TMDB status code returned when an item was created successfully
+	StatusCodeSuccess int32 = 1
+
+	// StatusCodeItemUpdated is the TMDB status code returned when an item was updated successfully
+	StatusCodeItemUpdated int32 = 12
+
+	// StatusCodeItemDeleted is the TMDB status code returned when an item was deleted successfully
+	StatusCodeItemDeleted int32 = 13
 )
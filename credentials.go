@@ -0,0 +1,358 @@
+package gotmdbapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// RoundRobin rotates the API keys in order
+	RoundRobin RotationStrategy = iota
+
+	// LeastUsed uses the API key with the fewest requests, breaking the ties in rotation order
+	LeastUsed
+)
+
+const (
+	// DefaultUnauthorizedQuarantine is the default quarantine of the API keys rejected by the TMDB API as invalid or
+	// suspended, since they are likely revoked
+	DefaultUnauthorizedQuarantine = time.Hour
+
+	// DefaultRateLimitQuarantine is the default quarantine of the API keys rate limited with 429 Too Many Requests,
+	// when the response does not report when the rate limit resets
+	DefaultRateLimitQuarantine = 10 * time.Second
+
+	// RetryAfterHeader is the response header with the number of seconds to wait before retrying
+	RetryAfterHeader = "Retry-After"
+
+	// TMDBStatusInvalidAPIKey is the TMDB API status code of the requests with an invalid API key
+	TMDBStatusInvalidAPIKey int32 = 7
+
+	// TMDBStatusSuspendedAPIKey is the TMDB API status code of the requests with a suspended API key
+	TMDBStatusSuspendedAPIKey int32 = 10
+)
+
+type (
+	// CredentialProvider provides the API keys used to authenticate the TMDB API requests, and is told the outcome of
+	// each request so it can rotate or quarantine them
+	//
+	// The API key is picked once per call, when the request is created, so the retries of the middlewares resend it
+	// even if it was rate limited, and the outcome reported is the one of the last attempt.
+	CredentialProvider interface {
+		// Credential returns the API key for a request
+		Credential(ctx context.Context) (string, error)
+
+		// Report reports the outcome of a request made with an API key
+		Report(apiKey string, meta *ResponseMeta, err error)
+	}
+
+	// RotationStrategy is the strategy used by a KeyPool to pick the API keys
+	RotationStrategy int
+
+	// KeyPoolOptions represents the options of a KeyPool
+	KeyPoolOptions struct {
+		// Strategy is the rotation strategy
+		Strategy RotationStrategy
+
+		// UnauthorizedQuarantine is the quarantine of the API keys rejected with 401 Unauthorized as invalid or
+		// suspended, where a zero value defaults to DefaultUnauthorizedQuarantine
+		UnauthorizedQuarantine time.Duration
+
+		// RateLimitQuarantine is the quarantine of the API keys rate limited with 429 Too Many Requests, when the
+		// response does not report when the rate limit resets, where a zero value defaults to
+		// DefaultRateLimitQuarantine
+		RateLimitQuarantine time.Duration
+	}
+
+	// KeyStats represents the usage of an API key of a KeyPool
+	KeyStats struct {
+		// Index is the position of the API key in the pool
+		Index int
+
+		// Key is the API key masked to its last characters
+		Key string
+
+		// Requests is the number of requests made with the API key
+		Requests int64
+
+		// Failures is the number of requests made with the API key that failed, for any reason
+		Failures int64
+
+		// Unauthorized is the number of requests rejected for an invalid or suspended API key
+		Unauthorized int64
+
+		// RateLimited is the number of requests rejected with 429 Too Many Requests
+		RateLimited int64
+
+		// LastUsed is the last time the API key was picked
+		LastUsed time.Time
+
+		// QuarantinedUntil is the end of the quarantine of the API key, or the zero time if it was never quarantined
+		QuarantinedUntil time.Time
+	}
+
+	// KeyPool is a CredentialProvider that rotates several API keys, quarantining those rejected or rate limited by
+	// the TMDB API, so the load is spread and a revoked key does not take the service down
+	KeyPool struct {
+		mutex   sync.Mutex
+		keys    []string
+		stats   []KeyStats
+		indexes map[string]int
+		next    int
+		opts    KeyPoolOptions
+		now     func() time.Time
+	}
+
+	// staticCredential is the CredentialProvider of a single API key
+	staticCredential string
+
+	// credentialContextKey is the context key of the API key used by a request
+	credentialContextKey struct{}
+)
+
+// Credential returns the API key
+//
+// Parameters:
+//
+// - ctx: the context of the request
+//
+// Returns:
+//
+// - string: the API key
+// - error: always nil
+func (s staticCredential) Credential(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// Report does nothing, since there is a single API key
+//
+// Parameters:
+//
+// - apiKey: the API key
+// - meta: the response metadata
+// - err: the error of the request (optional)
+func (s staticCredential) Report(string, *ResponseMeta, error) {}
+
+// NewKeyPool creates a new pool of API keys
+//
+// Parameters:
+//
+// - keys: the API keys
+// - opts: the pool options (optional)
+//
+// Returns:
+//
+// - *KeyPool: the pool of API keys
+// - error: if there are no API keys or any of them is empty
+func NewKeyPool(keys []string, opts *KeyPoolOptions) (*KeyPool, error) {
+	if len(keys) == 0 {
+		return nil, ErrEmptyAPIKey
+	}
+
+	pool := &KeyPool{
+		keys:    make([]string, len(keys)),
+		stats:   make([]KeyStats, len(keys)),
+		indexes: make(map[string]int, len(keys)),
+		now:     time.Now,
+	}
+	for i, key := range keys {
+		if key == "" {
+			return nil, ErrEmptyAPIKey
+		}
+		pool.keys[i] = key
+		pool.stats[i] = KeyStats{Index: i, Key: MaskCredential(key)}
+		pool.indexes[key] = i
+	}
+
+	if opts != nil {
+		pool.opts = *opts
+	}
+	if pool.opts.UnauthorizedQuarantine <= 0 {
+		pool.opts.UnauthorizedQuarantine = DefaultUnauthorizedQuarantine
+	}
+	if pool.opts.RateLimitQuarantine <= 0 {
+		pool.opts.RateLimitQuarantine = DefaultRateLimitQuarantine
+	}
+	return pool, nil
+}
+
+// Credential returns the next API key that is not quarantined, following the rotation strategy
+//
+// Parameters:
+//
+// - ctx: the context of the request
+//
+// Returns:
+//
+// - string: the API key
+// - error: ErrNoAvailableAPIKey if all the API keys are quarantined
+func (p *KeyPool) Credential(context.Context) (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := p.now()
+	picked := -1
+	for offset := range p.keys {
+		i := (p.next + offset) % len(p.keys)
+		if p.stats[i].QuarantinedUntil.After(now) {
+			continue
+		}
+		if picked == -1 {
+			picked = i
+			if p.opts.Strategy == RoundRobin {
+				break
+			}
+		} else if p.stats[i].Requests < p.stats[picked].Requests {
+			picked = i
+		}
+	}
+	if picked == -1 {
+		return "", ErrNoAvailableAPIKey
+	}
+
+	p.next = (picked + 1) % len(p.keys)
+	p.stats[picked].Requests++
+	p.stats[picked].LastUsed = now
+	return p.keys[picked], nil
+}
+
+// Report records the outcome of a request, quarantining the API key if it was rejected with 401 Unauthorized as invalid
+// or suspended, or rate limited with 429 Too Many Requests
+//
+// The 401 Unauthorized responses of the user-level failures, such as a wrong login password or an invalid session,
+// do not quarantine the API key, since it is still valid.
+//
+// Parameters:
+//
+// - apiKey: the API key
+// - meta: the response metadata
+// - err: the error of the request (optional)
+func (p *KeyPool) Report(apiKey string, meta *ResponseMeta, err error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	i, ok := p.indexes[apiKey]
+	if !ok {
+		return
+	}
+	stats := &p.stats[i]
+	if err != nil {
+		stats.Failures++
+	}
+
+	now := p.now()
+	switch meta.StatusCode {
+	case http.StatusUnauthorized:
+		if meta.TMDBStatusCode != TMDBStatusInvalidAPIKey && meta.TMDBStatusCode != TMDBStatusSuspendedAPIKey {
+			return
+		}
+		stats.Unauthorized++
+		stats.QuarantinedUntil = now.Add(p.opts.UnauthorizedQuarantine)
+	case http.StatusTooManyRequests:
+		stats.RateLimited++
+		stats.QuarantinedUntil = p.rateLimitQuarantine(now, meta)
+	default:
+	}
+}
+
+// Stats returns the usage of each API key of the pool, in the order they were given
+//
+// Returns:
+//
+// - []KeyStats: the usage of each API key
+func (p *KeyPool) Stats() []KeyStats {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	stats := make([]KeyStats, len(p.stats))
+	copy(stats, p.stats)
+	return stats
+}
+
+// rateLimitQuarantine returns the end of the quarantine of a rate limited API key, from the Retry-After header or the
+// rate limit reset reported by the response, falling back to the configured quarantine
+//
+// Parameters:
+//
+// - now: the current time
+// - meta: the response metadata
+//
+// Returns:
+//
+// - time.Time: the end of the quarantine
+func (p *KeyPool) rateLimitQuarantine(now time.Time, meta *ResponseMeta) time.Time {
+	if seconds, err := strconv.Atoi(meta.Header.Get(RetryAfterHeader)); err == nil && seconds > 0 {
+		return now.Add(time.Duration(seconds) * time.Second)
+	}
+	if meta.RateLimitReset.After(now) {
+		return meta.RateLimitReset
+	}
+	return now.Add(p.opts.RateLimitQuarantine)
+}
+
+// MaskCredential masks a credential, such as an API key, to its last four characters, so it can be identified in logs
+// and metrics without being leaked
+//
+// Parameters:
+//
+// - credential: the credential
+//
+// Returns:
+//
+// - string: the masked credential
+func MaskCredential(credential string) string {
+	const visible = 4
+	if len(credential) <= 2*visible {
+		return RedactedValue
+	}
+	return "..." + credential[len(credential)-visible:]
+}
+
+// NewClientWithCredentials creates a new TMDB API client that authenticates the requests with the API keys of a
+// credential provider, such as a KeyPool
+//
+// Parameters:
+//
+// - credentials: the credential provider
+//
+// Returns:
+//
+// - *Client: the TMDB API client
+// - error: if the credential provider is nil
+func NewClientWithCredentials(credentials CredentialProvider) (*Client, error) {
+	if credentials == nil {
+		return nil, ErrNilCredentialProvider
+	}
+
+	return &Client{
+		credentials: credentials,
+	}, nil
+}
+
+// credentialProvider returns the credential provider of the client
+//
+// Returns:
+//
+// - CredentialProvider: the credential provider, or the client API key if there is none
+func (c Client) credentialProvider() CredentialProvider {
+	if c.credentials != nil {
+		return c.credentials
+	}
+	return staticCredential(c.apiKey)
+}
+
+// reportCredential reports the outcome of a request to the credential provider, if the request used one of its keys
+//
+// Parameters:
+//
+// - req: the HTTP request
+// - meta: the response metadata
+// - err: the error of the request (optional)
+func (c Client) reportCredential(req *http.Request, meta *ResponseMeta, err error) {
+	if apiKey, _ := req.Context().Value(credentialContextKey{}).(string); apiKey != "" {
+		c.credentialProvider().Report(apiKey, meta, err)
+	}
+}
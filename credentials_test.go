@@ -0,0 +1,264 @@
+package gotmdbapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestKeyPoolRotation tests that the API keys are rotated following the strategy
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestKeyPoolRotation(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy RotationStrategy
+		used     []int64
+		expected []string
+	}{
+		{
+			name:     "round robin",
+			strategy: RoundRobin,
+			expected: []string{"key-a", "key-b", "key-c", "key-a"},
+		},
+		{
+			name:     "least used",
+			strategy: LeastUsed,
+			used:     []int64{2, 0, 1},
+			expected: []string{"key-b", "key-c", "key-b", "key-c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				pool, err := NewKeyPool([]string{"key-a", "key-b", "key-c"}, &KeyPoolOptions{Strategy: tt.strategy})
+				if err != nil {
+					t.Fatalf("NewKeyPool() failed: %v", err)
+				}
+				for i, used := range tt.used {
+					pool.stats[i].Requests = used
+				}
+
+				var got []string
+				for range tt.expected {
+					key, err := pool.Credential(context.Background())
+					if err != nil {
+						t.Fatalf("Credential() failed: %v", err)
+					}
+					got = append(got, key)
+				}
+				if strings.Join(got, ",") != strings.Join(tt.expected, ",") {
+					t.Errorf("Credential() returned %v, expected %v", got, tt.expected)
+				}
+			},
+		)
+	}
+}
+
+// TestKeyPoolQuarantine tests that the API keys rejected or rate limited are quarantined until they can be used again
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestKeyPoolQuarantine(t *testing.T) {
+	pool, err := NewKeyPool([]string{"key-a", "key-b"}, nil)
+	if err != nil {
+		t.Fatalf("NewKeyPool() failed: %v", err)
+	}
+	now := time.Unix(1700000000, 0)
+	pool.now = func() time.Time { return now }
+
+	pool.Report(
+		"key-a",
+		&ResponseMeta{StatusCode: http.StatusUnauthorized, TMDBStatusCode: TMDBStatusInvalidAPIKey},
+		errors.New("unauthorized"),
+	)
+	pool.Report(
+		"key-b",
+		&ResponseMeta{StatusCode: http.StatusTooManyRequests, Header: http.Header{RetryAfterHeader: {"5"}}},
+		errors.New("rate limited"),
+	)
+	if _, err = pool.Credential(context.Background()); !errors.Is(err, ErrNoAvailableAPIKey) {
+		t.Fatalf("Credential() returned %v, expected %v", err, ErrNoAvailableAPIKey)
+	}
+
+	// The rate limited key is available once the Retry-After elapses
+	now = now.Add(5 * time.Second)
+	if key, err := pool.Credential(context.Background()); err != nil || key != "key-b" {
+		t.Fatalf("Credential() returned %q and %v, expected key-b", key, err)
+	}
+
+	stats := pool.Stats()
+	if stats[0].Unauthorized != 1 || stats[0].Failures != 1 || !stats[0].QuarantinedUntil.After(now) {
+		t.Errorf("stats of key-a are %+v, expected a quarantined unauthorized failure", stats[0])
+	}
+	if stats[1].RateLimited != 1 || stats[1].Requests != 1 || stats[1].Key != RedactedValue {
+		t.Errorf("stats of key-b are %+v, expected a rate limited key with a request", stats[1])
+	}
+}
+
+// TestClientWithKeyPool tests that the client rotates away from a revoked API key
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestClientWithKeyPool(t *testing.T) {
+	revoked, valid := "revoked-api-key-0001", "valid-api-key-0002"
+	pool, err := NewKeyPool([]string{revoked, valid}, nil)
+	if err != nil {
+		t.Fatalf("NewKeyPool() failed: %v", err)
+	}
+	client, err := NewClientWithCredentials(pool)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// Stub the TMDB API, rejecting the revoked API key
+	client = client.WithHTTPClient(
+		DoerFunc(
+			func(req *http.Request) (*http.Response, error) {
+				if req.Header.Get("Authorization") == "Bearer "+revoked {
+					return &http.Response{
+						StatusCode: http.StatusUnauthorized,
+						Body:       io.NopCloser(strings.NewReader(`{"success":false,"status_code":7}`)),
+						Request:    req,
+					}, nil
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"id":550}`)),
+					Request:    req,
+				}, nil
+			},
+		),
+	)
+
+	if _, _, err = client.GetMovieDetails(context.Background(), 550, ""); err == nil {
+		t.Fatal("GetMovieDetails() with the revoked API key succeeded, expected an error")
+	}
+	for range 2 {
+		if _, _, err = client.GetMovieDetails(context.Background(), 550, ""); err != nil {
+			t.Fatalf("GetMovieDetails() failed: %v", err)
+		}
+	}
+
+	stats := pool.Stats()
+	if stats[0].Requests != 1 || stats[0].Unauthorized != 1 || stats[1].Requests != 2 {
+		t.Errorf("pool stats are %+v, expected the revoked API key to be quarantined after a request", stats)
+	}
+	if stats[0].Key != "...0001" {
+		t.Errorf("masked key is %q, expected %q", stats[0].Key, "...0001")
+	}
+}
+
+// TestKeyPoolUserFailures tests that the 401 Unauthorized responses of the user-level failures, such as a wrong login
+// password or an invalid session, do not quarantine the API key
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestKeyPoolUserFailures(t *testing.T) {
+	pool, err := NewKeyPool([]string{"single-api-key-0001"}, nil)
+	if err != nil {
+		t.Fatalf("NewKeyPool() failed: %v", err)
+	}
+	client, err := NewClientWithCredentials(pool)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// Stub the TMDB API, rejecting the login and the session
+	client = client.WithHTTPClient(
+		DoerFunc(
+			func(req *http.Request) (*http.Response, error) {
+				body := `{"success":false,"status_code":3,"status_message":"Authentication failed"}`
+				if strings.HasSuffix(req.URL.Path, "/validate_with_login") {
+					body = `{"success":false,"status_code":30,"status_message":"Invalid username and/or password"}`
+				}
+				if strings.HasSuffix(req.URL.Path, "/movie/550") {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"id":550}`)),
+						Request:    req,
+					}, nil
+				}
+				return &http.Response{
+					StatusCode: http.StatusUnauthorized,
+					Body:       io.NopCloser(strings.NewReader(body)),
+					Request:    req,
+				}, nil
+			},
+		),
+	)
+
+	ctx := context.Background()
+	if _, _, err = client.ValidateRequestTokenWithLogin(ctx, "user", "wrong-password", "request-token"); err == nil {
+		t.Fatal("ValidateRequestTokenWithLogin() succeeded, expected an error")
+	}
+	if _, _, err = client.GetAccountDetails(ctx, 1, NewSession("expired-session")); err == nil {
+		t.Fatal("GetAccountDetails() succeeded, expected an error")
+	}
+	if _, _, err = client.GetMovieDetails(ctx, 550, ""); err != nil {
+		t.Fatalf("GetMovieDetails() failed after the user-level failures: %v", err)
+	}
+
+	stats := pool.Stats()
+	if stats[0].Unauthorized != 0 || stats[0].Failures != 2 || !stats[0].QuarantinedUntil.IsZero() {
+		t.Errorf("pool stats are %+v, expected two failures without quarantine", stats[0])
+	}
+}
+
+// TestKeyPoolUnsentRequests tests that the API key is only picked once the request is sent, so the requests that fail
+// to be built are not counted
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestKeyPoolUnsentRequests(t *testing.T) {
+	pool, err := NewKeyPool([]string{"key-a", "key-b"}, &KeyPoolOptions{Strategy: LeastUsed})
+	if err != nil {
+		t.Fatalf("NewKeyPool() failed: %v", err)
+	}
+	client, err := NewClientWithCredentials(pool)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client = client.WithHTTPClient(
+		DoerFunc(
+			func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"id":550}`)),
+					Request:    req,
+				}, nil
+			},
+		),
+	)
+
+	apiURL := fmt.Sprintf(GetMovieDetailsURL, "550")
+	req, err := buildRequest(context.Background(), "GetMovieDetails", http.MethodGet, apiURL, nil)
+	if err != nil {
+		t.Fatalf("buildRequest() failed: %v", err)
+	}
+	for _, stats := range pool.Stats() {
+		if stats.Requests != 0 {
+			t.Fatalf("pool stats are %+v after building the request, expected no requests", stats)
+		}
+	}
+
+	if _, err = client.doRequest(req, &MovieDetailsResponse{}); err != nil {
+		t.Fatalf("doRequest() failed: %v", err)
+	}
+	stats := pool.Stats()
+	if stats[0].Requests+stats[1].Requests != 1 || stats[0].Failures+stats[1].Failures != 0 {
+		t.Errorf("pool stats are %+v, expected a single successful request", stats)
+	}
+}
@@ -0,0 +1,166 @@
+package gotmdbapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type (
+	// Date represents a TMDB date in YYYY-MM-DD format, where the zero value represents an empty or null date
+	Date time.Time
+)
+
+// NewDate creates a new date
+//
+// Parameters:
+//
+// - year: the year
+// - month: the month
+// - day: the day of the month
+//
+// Returns:
+//
+// - Date: the date
+func NewDate(year int, month time.Month, day int) Date {
+	return Date(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// ParseDate parses a date in YYYY-MM-DD format
+//
+// Parameters:
+//
+// - value: the date in YYYY-MM-DD format, where an empty string is parsed as the zero date
+//
+// Returns:
+//
+// - Date: the parsed date
+// - error: if the date is not in YYYY-MM-DD format
+func ParseDate(value string) (Date, error) {
+	if value == "" {
+		return Date{}, nil
+	}
+
+	t, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return Date{}, fmt.Errorf(ErrInvalidDate, value)
+	}
+	return Date(t), nil
+}
+
+// Time returns the date as a time.Time at midnight UTC
+//
+// Returns:
+//
+// - time.Time: the date as a time.Time
+func (d Date) Time() time.Time {
+	return time.Time(d)
+}
+
+// IsZero returns whether the date is empty
+//
+// Returns:
+//
+// - bool: true if the date is empty
+func (d Date) IsZero() bool {
+	return time.Time(d).IsZero()
+}
+
+// Before returns whether the date is before the given date
+//
+// Parameters:
+//
+// - other: the date to compare with
+//
+// Returns:
+//
+// - bool: true if the date is before the given date
+func (d Date) Before(other Date) bool {
+	return time.Time(d).Before(time.Time(other))
+}
+
+// After returns whether the date is after the given date
+//
+// Parameters:
+//
+// - other: the date to compare with
+//
+// Returns:
+//
+// - bool: true if the date is after the given date
+func (d Date) After(other Date) bool {
+	return time.Time(d).After(time.Time(other))
+}
+
+// String returns the date in YYYY-MM-DD format, or an empty string if the date is empty
+//
+// Returns:
+//
+// - string: the date in YYYY-MM-DD format
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return time.Time(d).Format(DateLayout)
+}
+
+// MarshalJSON marshals the date in YYYY-MM-DD format, or as null if the date is empty
+//
+// Returns:
+//
+// - []byte: the JSON data
+// - error: if there was an error marshalling the date
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON unmarshals a date in YYYY-MM-DD format, tolerating null and empty strings as the zero date
+//
+// Parameters:
+//
+// - data: the JSON data
+//
+// Returns:
+//
+// - error: if there was an error unmarshalling the date
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*d = Date{}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	parsed, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// UnmarshalText parses a date in YYYY-MM-DD format, such as a query parameter value, where an empty text is parsed as
+// the zero date
+//
+// Parameters:
+//
+// - text: the date in YYYY-MM-DD format
+//
+// Returns:
+//
+// - error: if the date is not in YYYY-MM-DD format
+func (d *Date) UnmarshalText(text []byte) error {
+	parsed, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
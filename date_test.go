@@ -0,0 +1,64 @@
+package gotmdbapi
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// TestDateUnmarshalJSON tests the unmarshalling of TMDB dates
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestDateUnmarshalJSON(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		data     string
+		wantDate Date
+		wantErr  bool
+	}{
+		{name: "valid date", data: `"1999-10-15"`, wantDate: NewDate(1999, time.October, 15)},
+		{name: "empty string", data: `""`},
+		{name: "null", data: `null`},
+		{name: "malformed date", data: `"15/10/1999"`, wantErr: true},
+	} {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				var date Date
+				err := json.Unmarshal([]byte(tc.data), &date)
+				if tc.wantErr {
+					if err == nil {
+						t.Fatal("Unmarshal returned nil error")
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("Unmarshal returned unexpected error: %v", err)
+				}
+				if !date.Time().Equal(tc.wantDate.Time()) {
+					t.Fatalf("Unmarshal returned %s, expected %s", date, tc.wantDate)
+				}
+			},
+		)
+	}
+}
+
+// TestDateMarshalJSON tests the marshalling of TMDB dates
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestDateMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(
+		DateRange{
+			Maximum: NewDate(2024, time.February, 29),
+		},
+	)
+	if err != nil {
+		t.Fatalf("Marshal returned unexpected error: %v", err)
+	}
+	if want := `{"maximum":"2024-02-29","minimum":null}`; string(data) != want {
+		t.Fatalf("Marshal returned %s, expected %s", data, want)
+	}
+}
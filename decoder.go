@@ -0,0 +1,196 @@
+package gotmdbapi
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type (
+	// QueryParameterError is the error of a single query parameter that could not be decoded
+	QueryParameterError struct {
+		Name  string
+		Value string
+		Err   error
+	}
+)
+
+// Error returns the error message, including the query parameter name and value
+//
+// Returns:
+//
+// - string: the error message
+func (e *QueryParameterError) Error() string {
+	return fmt.Sprintf(ErrInvalidQueryValue, e.Name, e.Value, e.Err)
+}
+
+// Unwrap returns ErrInvalidQueryParameter and the underlying error
+//
+// Returns:
+//
+// - []error: the wrapped errors
+func (e *QueryParameterError) Unwrap() []error {
+	return []error{ErrInvalidQueryParameter, e.Err}
+}
+
+// DecodeQueryParameters sets the tagged fields of a query parameter struct from the query parameters, as the inverse of
+// EncodeQueryParameters
+//
+// Missing and empty query parameters leave their fields untouched, and query parameters without a tagged field are
+// ignored. Every query parameter that could not be decoded is reported as a *QueryParameterError, all at once.
+//
+// Parameters:
+//
+// - query: the HTTP request query parameters
+// - params: the pointer to the query parameter struct
+//
+// Returns:
+//
+// - error: the joined errors for each query parameter that could not be decoded
+func DecodeQueryParameters(query url.Values, params any) error {
+	v := reflect.ValueOf(params)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf(ErrUnsupportedQueryType, ErrQueryDecoding, reflect.TypeOf(params), "params")
+	}
+	v = v.Elem()
+
+	var errs []error
+	t := v.Type()
+	for i := range t.NumField() {
+		structField := t.Field(i)
+		field, ok := parseQueryTag(structField.Tag.Get(QueryTag))
+		if !ok || !structField.IsExported() {
+			continue
+		}
+
+		values := query[field.name]
+		if len(values) == 0 || values[0] == "" {
+			continue
+		}
+		if len(values) > 1 {
+			errs = append(
+				errs, &QueryParameterError{
+					Name:  field.name,
+					Value: strings.Join(values, AndSeparator),
+					Err:   ErrRepeatedQueryParameter,
+				},
+			)
+			continue
+		}
+
+		if err := decodeQueryValue(v.Field(i), field, values[0]); err != nil {
+			errs = append(errs, &QueryParameterError{Name: field.name, Value: values[0], Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// decodeQueryValue decodes a query parameter value into a field value
+//
+// Parameters:
+//
+// - v: the field value
+// - field: the parsed struct tag of the field
+// - value: the query parameter value
+//
+// Returns:
+//
+// - error: if the value could not be decoded
+func decodeQueryValue(v reflect.Value, field queryField, value string) error {
+	// Allocate the pointers, since any value sent is meaningful
+	if v.Kind() == reflect.Pointer {
+		elem := reflect.New(v.Type().Elem())
+		if err := parseQueryScalar(elem.Elem(), value); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	// Split the slices by the field separator
+	if v.Kind() == reflect.Slice {
+		parts := strings.Split(value, field.separator)
+		slice := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := parseQueryScalar(slice.Index(i), part); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+	}
+	return parseQueryScalar(v, value)
+}
+
+// parseQueryScalar parses a query parameter value into a scalar value
+//
+// Parameters:
+//
+// - v: the scalar value
+// - value: the query parameter value
+//
+// Returns:
+//
+// - error: if the value could not be parsed or has an unsupported type
+func parseQueryScalar(v reflect.Value, value string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		v.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(value, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(parsed)
+	case reflect.Struct:
+		unmarshaler, ok := v.Addr().Interface().(encoding.TextUnmarshaler)
+		if !ok {
+			return fmt.Errorf(ErrUnsupportedType, ErrQueryDecoding, v.Type())
+		}
+		return unmarshaler.UnmarshalText([]byte(value))
+	default:
+		return fmt.Errorf(ErrUnsupportedType, ErrQueryDecoding, v.Type())
+	}
+	return nil
+}
+
+// ParseDiscoverMoviesQuery parses the query parameters for discovering movies, as the inverse of
+// AddGenreMovieListQueryParameters, so TMDB compatible query strings can be forwarded to DiscoverMovies
+//
+// Parameters:
+//
+// - query: the HTTP request query parameters
+//
+// Returns:
+//
+// - *DiscoverMoviesQueryParameters: the parsed query parameters
+// - error: the joined *QueryParameterError for each query parameter that could not be parsed
+func ParseDiscoverMoviesQuery(query url.Values) (*DiscoverMoviesQueryParameters, error) {
+	var params DiscoverMoviesQueryParameters
+	if err := DecodeQueryParameters(query, &params); err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
@@ -0,0 +1,139 @@
+package gotmdbapi
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// TestParseDiscoverMoviesQueryRoundTrip tests that parsing and encoding the query parameters is lossless
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestParseDiscoverMoviesQueryRoundTrip(t *testing.T) {
+	params, err := NewDiscoverMovies().
+		Certification("US", "PG-13").
+		IncludeAdult(false).
+		Language("es-MX").
+		Page(2).
+		PrimaryReleaseYearBetween(2000, 2010).
+		ReleasedBetween(NewDate(2000, time.January, 1), NewDate(2010, time.December, 31)).
+		SortBy(SortByPopularityDesc).
+		VoteAverageBetween(0, 7.5).
+		Genres(AnyOf[GenreID](28, 12)).
+		Cast(AllOf[PersonID](287, 819)).
+		WithoutKeywords(AllOf[KeywordID](9715)).
+		ReleaseTypes(AnyOf(ReleaseTypeTheatrical, ReleaseTypeDigital)).
+		WatchProviders("US", AnyOf[WatchProviderID](8, 337)).
+		WatchMonetizationTypes(AnyOf(WatchMonetizationTypeFlatrate, WatchMonetizationTypeFree)).
+		Build()
+	if err != nil {
+		t.Fatalf("Build returned unexpected error: %v", err)
+	}
+
+	encoded := url.Values{}
+	if err = EncodeQueryParameters(encoded, params); err != nil {
+		t.Fatalf("EncodeQueryParameters returned unexpected error: %v", err)
+	}
+
+	parsed, err := ParseDiscoverMoviesQuery(encoded)
+	if err != nil {
+		t.Fatalf("ParseDiscoverMoviesQuery returned unexpected error: %v", err)
+	}
+
+	reencoded := url.Values{}
+	if err = EncodeQueryParameters(reencoded, parsed); err != nil {
+		t.Fatalf("EncodeQueryParameters returned unexpected error: %v", err)
+	}
+	if got, want := reencoded.Encode(), encoded.Encode(); got != want {
+		t.Fatalf("Round trip encoded %q, expected %q", got, want)
+	}
+	if !parsed.WithGenres.IsAnyOf() || parsed.WithCast.IsAnyOf() {
+		t.Fatalf("Round trip lost the filter compositions: %+v", parsed)
+	}
+}
+
+// TestParseDiscoverMoviesQueryEnumFilters tests that the release types and monetization types keep their AND or OR
+// composition through a parse and encode round trip
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestParseDiscoverMoviesQueryEnumFilters(t *testing.T) {
+	for _, rawQuery := range []string{
+		"watch_region=US&with_release_type=2%2C3&with_watch_monetization_types=flatrate%2Crent",
+		"watch_region=US&with_release_type=2%7C3&with_watch_monetization_types=flatrate%7Crent",
+	} {
+		t.Run(
+			rawQuery, func(t *testing.T) {
+				query, err := url.ParseQuery(rawQuery)
+				if err != nil {
+					t.Fatalf("Failed to parse query: %v", err)
+				}
+
+				params, err := ParseDiscoverMoviesQuery(query)
+				if err != nil {
+					t.Fatalf("ParseDiscoverMoviesQuery returned unexpected error: %v", err)
+				}
+				releaseTypes := params.WithReleaseType.IDs()
+				if len(releaseTypes) != 2 || releaseTypes[0] != ReleaseTypeTheatricalLimited ||
+					releaseTypes[1] != ReleaseTypeTheatrical {
+					t.Fatalf("ParseDiscoverMoviesQuery returned release types %v", releaseTypes)
+				}
+				if params.WithReleaseType.IsAnyOf() != params.WithWatchMonetizationTypes.IsAnyOf() {
+					t.Fatalf("ParseDiscoverMoviesQuery mixed the filter compositions: %+v", params)
+				}
+
+				encoded := url.Values{}
+				if err = EncodeQueryParameters(encoded, params); err != nil {
+					t.Fatalf("EncodeQueryParameters returned unexpected error: %v", err)
+				}
+				if got := encoded.Encode(); got != rawQuery {
+					t.Fatalf("Round trip encoded %q, expected %q", got, rawQuery)
+				}
+			},
+		)
+	}
+}
+
+// TestParseDiscoverMoviesQueryErrors tests that every invalid query parameter is reported
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestParseDiscoverMoviesQueryErrors(t *testing.T) {
+	query, err := url.ParseQuery(
+		"include_adult=maybe&page=two&release_date.gte=2024-13-01&with_genres=28,12|16&year=2020&year=2021" +
+			"&sort_by=popularity.desc&unknown=ignored",
+	)
+	if err != nil {
+		t.Fatalf("Failed to parse query: %v", err)
+	}
+
+	_, err = ParseDiscoverMoviesQuery(query)
+	if !errors.Is(err, ErrInvalidQueryParameter) {
+		t.Fatalf("ParseDiscoverMoviesQuery returned %v, expected %v", err, ErrInvalidQueryParameter)
+	}
+	if !errors.Is(err, ErrMixedIDFilter) || !errors.Is(err, ErrRepeatedQueryParameter) {
+		t.Fatalf("ParseDiscoverMoviesQuery returned %v, expected the filter and repeated errors", err)
+	}
+
+	// Check every invalid query parameter is reported
+	names := make(map[string]bool)
+	for _, joined := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var paramErr *QueryParameterError
+		if errors.As(joined, &paramErr) {
+			names[paramErr.Name] = true
+		}
+	}
+	for _, name := range []string{IncludeAdult, Page, ReleaseDateGTE, WithGenres, Year} {
+		if !names[name] {
+			t.Fatalf("ParseDiscoverMoviesQuery did not report %s: %v", name, err)
+		}
+	}
+	if len(names) != 5 {
+		t.Fatalf("ParseDiscoverMoviesQuery reported %d query parameters, expected 5: %v", len(names), err)
+	}
+}
@@ -0,0 +1,245 @@
+package gotmdbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+type (
+	// Defaults represents the query parameters applied to the requests that support them when they are not set by the
+	// method arguments or options, where empty strings and a nil IncludeAdult are not applied, and the language
+	// fallback chain used to fill the empty localized fields of the movies, where ListFallbackLimit caps the movies
+	// of a list response filled from the chain, so 0 is not applied and a negative limit disables it
+	Defaults struct {
+		Language          string
+		Region            string
+		IncludeAdult      *bool
+		WatchRegion       string
+		FallbackLanguages []string
+		ListFallbackLimit int
+	}
+
+	// defaultsContextKey is the context key of the per-call defaults
+	defaultsContextKey struct{}
+)
+
+// Merge returns the defaults overridden by the set fields of the given defaults
+//
+// Parameters:
+//
+// - override: the defaults that take precedence
+//
+// Returns:
+//
+// - Defaults: the merged defaults
+func (d Defaults) Merge(override Defaults) Defaults {
+	if override.Language != "" {
+		d.Language = override.Language
+	}
+	if override.Region != "" {
+		d.Region = override.Region
+	}
+	if override.IncludeAdult != nil {
+		d.IncludeAdult = override.IncludeAdult
+	}
+	if override.WatchRegion != "" {
+		d.WatchRegion = override.WatchRegion
+	}
+	if override.FallbackLanguages != nil {
+		d.FallbackLanguages = override.FallbackLanguages
+	}
+	if override.ListFallbackLimit != 0 {
+		d.ListFallbackLimit = override.ListFallbackLimit
+	}
+	return d
+}
+
+// ContextWithDefaults returns a copy of the context with per-call defaults, which take precedence over the client
+// defaults but not over the method arguments or options
+//
+// Parameters:
+//
+// - ctx: the parent context
+// - defaults: the per-call defaults
+//
+// Returns:
+//
+// - context.Context: the context with the per-call defaults
+func ContextWithDefaults(ctx context.Context, defaults Defaults) context.Context {
+	if parent, ok := DefaultsFromContext(ctx); ok {
+		defaults = parent.Merge(defaults)
+	}
+	return context.WithValue(ctx, defaultsContextKey{}, defaults)
+}
+
+// DefaultsFromContext returns the per-call defaults of the context
+//
+// Parameters:
+//
+// - ctx: the context
+//
+// Returns:
+//
+// - Defaults: the per-call defaults
+// - bool: true if the context has per-call defaults
+func DefaultsFromContext(ctx context.Context) (Defaults, bool) {
+	defaults, ok := ctx.Value(defaultsContextKey{}).(Defaults)
+	return defaults, ok
+}
+
+// Defaults returns the client defaults
+//
+// Returns:
+//
+// - Defaults: the client defaults
+func (c Client) Defaults() Defaults {
+	return c.defaults
+}
+
+// WithDefaults creates a derived client whose defaults are overridden by the set fields of the given defaults
+//
+// Parameters:
+//
+// - defaults: the defaults that take precedence
+//
+// Returns:
+//
+// - *Client: the derived client
+func (c Client) WithDefaults(defaults Defaults) *Client {
+	c.defaults = c.defaults.Merge(defaults)
+	return &c
+}
+
+// WithLanguage creates a derived client with the given default language
+//
+// Parameters:
+//
+// - language: the language code, such as es-MX
+//
+// Returns:
+//
+// - *Client: the derived client
+func (c Client) WithLanguage(language string) *Client {
+	return c.WithDefaults(Defaults{Language: language})
+}
+
+// WithRegion creates a derived client with the given default region
+//
+// Parameters:
+//
+// - region: the ISO 3166-1 region code
+//
+// Returns:
+//
+// - *Client: the derived client
+func (c Client) WithRegion(region string) *Client {
+	return c.WithDefaults(Defaults{Region: region})
+}
+
+// WithIncludeAdult creates a derived client that includes or excludes adult content by default
+//
+// Parameters:
+//
+// - includeAdult: whether to include adult content
+//
+// Returns:
+//
+// - *Client: the derived client
+func (c Client) WithIncludeAdult(includeAdult bool) *Client {
+	return c.WithDefaults(Defaults{IncludeAdult: &includeAdult})
+}
+
+// WithWatchRegion creates a derived client with the given default watch region
+//
+// Parameters:
+//
+// - watchRegion: the ISO 3166-1 region code
+//
+// Returns:
+//
+// - *Client: the derived client
+func (c Client) WithWatchRegion(watchRegion string) *Client {
+	return c.WithDefaults(Defaults{WatchRegion: watchRegion})
+}
+
+// WithFallbackLanguages creates a derived client with the given language fallback chain, used to fill the empty
+// localized fields of the movies from their translations. Each movie details response with empty fields costs an extra
+// translations request, while list responses are only filled when enabled with WithListFallbackLimit, costing up to
+// one extra request per movie of the page
+//
+// Parameters:
+//
+// - languages: the languages to try in order, such as pt-PT and en-US, where a language without region matches any
+// region
+//
+// Returns:
+//
+// - *Client: the derived client
+func (c Client) WithFallbackLanguages(languages ...string) *Client {
+	return c.WithDefaults(Defaults{FallbackLanguages: languages})
+}
+
+// WithListFallbackLimit creates a derived client that fills the empty localized fields of the movies of list responses
+// from the language fallback chain, fetching the translations of at most the given number of movies per response
+//
+// Parameters:
+//
+// - limit: the maximum number of translations requests per list response, where a negative limit disables it
+//
+// Returns:
+//
+// - *Client: the derived client
+func (c Client) WithListFallbackLimit(limit int) *Client {
+	return c.WithDefaults(Defaults{ListFallbackLimit: limit})
+}
+
+// resolveDefaults returns the client defaults overridden by the per-call defaults of the context
+//
+// Parameters:
+//
+// - ctx: the context of the request
+//
+// Returns:
+//
+// - Defaults: the resolved defaults
+func (c Client) resolveDefaults(ctx context.Context) Defaults {
+	if override, ok := DefaultsFromContext(ctx); ok {
+		return c.defaults.Merge(override)
+	}
+	return c.defaults
+}
+
+// addDefaultQueryParameters adds the defaults of the given query parameters to the HTTP request, for those not
+// already set, where the per-call defaults of the request context take precedence over the client defaults
+//
+// Parameters:
+//
+// - req: the HTTP request
+// - keys: the query parameters supported by the endpoint, out of Language, Region, IncludeAdult and WatchRegion
+func (c Client) addDefaultQueryParameters(req *http.Request, keys ...string) {
+	defaults := c.resolveDefaults(req.Context())
+
+	q := req.URL.Query()
+	for _, key := range keys {
+		if q.Has(key) {
+			continue
+		}
+
+		switch key {
+		case Language:
+			AddLanguageQueryParameter(q, defaults.Language)
+		case Region:
+			AddRegionQueryParameter(q, defaults.Region)
+		case IncludeAdult:
+			if defaults.IncludeAdult != nil {
+				AddIncludeAdultQueryParameter(q, *defaults.IncludeAdult)
+			}
+		case WatchRegion:
+			if defaults.WatchRegion != "" {
+				q.Add(WatchRegion, defaults.WatchRegion)
+			}
+		default:
+		}
+	}
+	req.URL.RawQuery = q.Encode()
+}
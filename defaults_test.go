@@ -0,0 +1,133 @@
+package gotmdbapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// TestClientDefaults tests the precedence of the explicit, per-call and client defaults
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestClientDefaults(t *testing.T) {
+	client, err := NewClient("api-key")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	derived := client.WithLanguage("es-MX").WithRegion("MX").WithIncludeAdult(false)
+
+	// Check the derived client does not modify the original one
+	if client.Defaults().Language != "" {
+		t.Fatalf("WithLanguage modified the original client defaults: %+v", client.Defaults())
+	}
+
+	ctx := ContextWithDefaults(context.Background(), Defaults{Region: "AR"})
+	for _, tc := range []struct {
+		name   string
+		ctx    context.Context
+		rawURL string
+		want   string
+	}{
+		{
+			name:   "client defaults",
+			ctx:    context.Background(),
+			rawURL: SearchMoviesURL,
+			want:   "include_adult=false&language=es-MX&region=MX",
+		},
+		{
+			name:   "per-call defaults",
+			ctx:    ctx,
+			rawURL: SearchMoviesURL,
+			want:   "include_adult=false&language=es-MX&region=AR",
+		},
+		{
+			name:   "explicit arguments",
+			ctx:    ctx,
+			rawURL: SearchMoviesURL + "?language=en-US&include_adult=true",
+			want:   "include_adult=true&language=en-US&region=AR",
+		},
+	} {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				req, err := http.NewRequestWithContext(tc.ctx, http.MethodGet, tc.rawURL, http.NoBody)
+				if err != nil {
+					t.Fatalf("Failed to create request: %v", err)
+				}
+
+				derived.addDefaultQueryParameters(req, Language, Region, IncludeAdult)
+				if got := req.URL.RawQuery; got != tc.want {
+					t.Fatalf("Query is %q, expected %q", got, tc.want)
+				}
+			},
+		)
+	}
+}
+
+// TestEndpointDefaults tests that the endpoints without a default of their own apply the client defaults
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestEndpointDefaults(t *testing.T) {
+	ctx := context.Background()
+	includeAdult := true
+	tests := []struct {
+		name  string
+		call  func(client *Client) error
+		query string
+	}{
+		{
+			name: "keyword movies",
+			call: func(client *Client) error {
+				_, _, err := client.GetKeywordMoviesWithOptions(ctx, 1, nil)
+				return err
+			},
+			query: "include_adult=false&language=es-MX",
+		},
+		{
+			name: "keyword movies with options",
+			call: func(client *Client) error {
+				_, _, err := client.GetKeywordMoviesWithOptions(
+					ctx,
+					1,
+					&KeywordMoviesOptions{IncludeAdult: &includeAdult, Page: 2},
+				)
+				return err
+			},
+			query: "include_adult=true&language=es-MX&page=2",
+		},
+		{
+			name: "v4 list",
+			call: func(client *Client) error {
+				_, _, err := client.V4().GetList(ctx, "", 1, "", 0, "")
+				return err
+			},
+			query: "language=es-MX",
+		},
+		{
+			name: "v4 list with language",
+			call: func(client *Client) error {
+				_, _, err := client.V4().GetList(ctx, "", 1, "en-US", 0, "")
+				return err
+			},
+			query: "language=en-US",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client, requests := newStubbedClient(t, "api-key", http.StatusOK, `{}`)
+				if err := tt.call(client.WithLanguage("es-MX").WithIncludeAdult(false)); err != nil {
+					t.Fatalf("call failed: %v", err)
+				}
+
+				if got := (*requests)[0].URL.RawQuery; got != tt.query {
+					t.Errorf("query is %q, expected %q", got, tt.query)
+				}
+			},
+		)
+	}
+}
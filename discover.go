@@ -0,0 +1,539 @@
+package gotmdbapi
+
+type (
+	// DiscoverMoviesBuilder builds the query parameters for discovering movies, only setting the given parameters
+	DiscoverMoviesBuilder struct {
+		params DiscoverMoviesQueryParameters
+	}
+)
+
+// ptr returns a pointer to the given value
+//
+// Parameters:
+//
+// - value: the value
+//
+// Returns:
+//
+// - *T: the pointer to the value
+func ptr[T any](value T) *T {
+	return &value
+}
+
+// NewDiscoverMovies creates a new builder for the query parameters for discovering movies
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func NewDiscoverMovies() *DiscoverMoviesBuilder {
+	return &DiscoverMoviesBuilder{}
+}
+
+// Certification filters the movies by the given certification in the given country
+//
+// Parameters:
+//
+// - country: the certification country, such as US
+// - certification: the certification, such as PG-13
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) Certification(country, certification string) *DiscoverMoviesBuilder {
+	b.params.CertificationCountry = country
+	b.params.Certification = certification
+	return b
+}
+
+// CertificationBetween filters the movies by a certification range in the given country
+//
+// Parameters:
+//
+// - country: the certification country, such as US
+// - gte: the lowest certification, or an empty string for no lower bound
+// - lte: the highest certification, or an empty string for no upper bound
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) CertificationBetween(country, gte, lte string) *DiscoverMoviesBuilder {
+	b.params.CertificationCountry = country
+	b.params.CertificationGTE = gte
+	b.params.CertificationLTE = lte
+	return b
+}
+
+// IncludeAdult sets whether to include adult content
+//
+// Parameters:
+//
+// - includeAdult: whether to include adult content
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) IncludeAdult(includeAdult bool) *DiscoverMoviesBuilder {
+	b.params.IncludeAdult = ptr(includeAdult)
+	return b
+}
+
+// IncludeVideo sets whether to include video content
+//
+// Parameters:
+//
+// - includeVideo: whether to include video content
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) IncludeVideo(includeVideo bool) *DiscoverMoviesBuilder {
+	b.params.IncludeVideo = ptr(includeVideo)
+	return b
+}
+
+// Language sets the language of the results
+//
+// Parameters:
+//
+// - language: the language, such as en-US
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) Language(language string) *DiscoverMoviesBuilder {
+	b.params.Language = language
+	return b
+}
+
+// Page sets the page of the results
+//
+// Parameters:
+//
+// - page: the page number
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) Page(page int32) *DiscoverMoviesBuilder {
+	b.params.Page = page
+	return b
+}
+
+// Region sets the region used for the release dates
+//
+// Parameters:
+//
+// - region: the ISO 3166-1 region code
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) Region(region string) *DiscoverMoviesBuilder {
+	b.params.Region = region
+	return b
+}
+
+// PrimaryReleaseYear filters the movies by their primary release year
+//
+// Parameters:
+//
+// - year: the primary release year
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) PrimaryReleaseYear(year int32) *DiscoverMoviesBuilder {
+	b.params.PrimaryReleaseYear = ptr(year)
+	return b
+}
+
+// PrimaryReleaseYearBetween filters the movies by a primary release year range, both bounds included
+//
+// Parameters:
+//
+// - gte: the earliest primary release year
+// - lte: the latest primary release year
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) PrimaryReleaseYearBetween(gte, lte int32) *DiscoverMoviesBuilder {
+	b.params.PrimaryReleaseYearGTE = ptr(gte)
+	b.params.PrimaryReleaseYearLTE = ptr(lte)
+	return b
+}
+
+// Year filters the movies by any of their release years
+//
+// Parameters:
+//
+// - year: the release year
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) Year(year int32) *DiscoverMoviesBuilder {
+	b.params.Year = ptr(year)
+	return b
+}
+
+// ReleasedBetween filters the movies by a release date range, both bounds included
+//
+// Parameters:
+//
+// - from: the earliest release date, or the zero date for no lower bound
+// - to: the latest release date, or the zero date for no upper bound
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) ReleasedBetween(from, to Date) *DiscoverMoviesBuilder {
+	b.params.ReleaseDateGTE = from
+	b.params.ReleaseDateLTE = to
+	return b
+}
+
+// SortBy sets the sort order of the results
+//
+// Parameters:
+//
+// - sortBy: the sort order
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) SortBy(sortBy SortByEnum) *DiscoverMoviesBuilder {
+	b.params.SortBy = sortBy
+	return b
+}
+
+// VoteAverageAtLeast filters the movies with a vote average greater than or equal to the given value
+//
+// Parameters:
+//
+// - gte: the minimum vote average
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) VoteAverageAtLeast(gte float32) *DiscoverMoviesBuilder {
+	b.params.VoteAverageGTE = ptr(gte)
+	return b
+}
+
+// VoteAverageBetween filters the movies by a vote average range, both bounds included
+//
+// Parameters:
+//
+// - gte: the minimum vote average
+// - lte: the maximum vote average
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) VoteAverageBetween(gte, lte float32) *DiscoverMoviesBuilder {
+	b.params.VoteAverageGTE = ptr(gte)
+	b.params.VoteAverageLTE = ptr(lte)
+	return b
+}
+
+// VoteCountAtLeast filters the movies with a vote count greater than or equal to the given value
+//
+// Parameters:
+//
+// - gte: the minimum vote count
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) VoteCountAtLeast(gte float32) *DiscoverMoviesBuilder {
+	b.params.VoteCountGTE = ptr(gte)
+	return b
+}
+
+// VoteCountBetween filters the movies by a vote count range, both bounds included
+//
+// Parameters:
+//
+// - gte: the minimum vote count
+// - lte: the maximum vote count
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) VoteCountBetween(gte, lte float32) *DiscoverMoviesBuilder {
+	b.params.VoteCountGTE = ptr(gte)
+	b.params.VoteCountLTE = ptr(lte)
+	return b
+}
+
+// RuntimeBetween filters the movies by a runtime range in minutes, both bounds included
+//
+// Parameters:
+//
+// - gte: the minimum runtime
+// - lte: the maximum runtime
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) RuntimeBetween(gte, lte int32) *DiscoverMoviesBuilder {
+	b.params.WithRuntimeGTE = ptr(gte)
+	b.params.WithRuntimeLTE = ptr(lte)
+	return b
+}
+
+// Genres filters the movies by their genres
+//
+// Parameters:
+//
+// - filter: the genres filter, built with AllOf or AnyOf
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) Genres(filter IDFilter[GenreID]) *DiscoverMoviesBuilder {
+	b.params.WithGenres = filter
+	return b
+}
+
+// WithoutGenres excludes the movies with the given genres
+//
+// Parameters:
+//
+// - filter: the genres filter, built with AllOf or AnyOf
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) WithoutGenres(filter IDFilter[GenreID]) *DiscoverMoviesBuilder {
+	b.params.WithoutGenres = filter
+	return b
+}
+
+// Companies filters the movies by their production companies
+//
+// Parameters:
+//
+// - filter: the companies filter, built with AllOf or AnyOf
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) Companies(filter IDFilter[CompanyID]) *DiscoverMoviesBuilder {
+	b.params.WithCompanies = filter
+	return b
+}
+
+// WithoutCompanies excludes the movies with the given production companies
+//
+// Parameters:
+//
+// - filter: the companies filter, built with AllOf or AnyOf
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) WithoutCompanies(filter IDFilter[CompanyID]) *DiscoverMoviesBuilder {
+	b.params.WithoutCompanies = filter
+	return b
+}
+
+// Keywords filters the movies by their keywords
+//
+// Parameters:
+//
+// - filter: the keywords filter, built with AllOf or AnyOf
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) Keywords(filter IDFilter[KeywordID]) *DiscoverMoviesBuilder {
+	b.params.WithKeywords = filter
+	return b
+}
+
+// WithoutKeywords excludes the movies with the given keywords
+//
+// Parameters:
+//
+// - filter: the keywords filter, built with AllOf or AnyOf
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) WithoutKeywords(filter IDFilter[KeywordID]) *DiscoverMoviesBuilder {
+	b.params.WithoutKeywords = filter
+	return b
+}
+
+// Cast filters the movies by the people in their cast
+//
+// Parameters:
+//
+// - filter: the cast filter, built with AllOf or AnyOf
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) Cast(filter IDFilter[PersonID]) *DiscoverMoviesBuilder {
+	b.params.WithCast = filter
+	return b
+}
+
+// Crew filters the movies by the people in their crew
+//
+// Parameters:
+//
+// - filter: the crew filter, built with AllOf or AnyOf
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) Crew(filter IDFilter[PersonID]) *DiscoverMoviesBuilder {
+	b.params.WithCrew = filter
+	return b
+}
+
+// People filters the movies by the people in either their cast or crew
+//
+// Parameters:
+//
+// - filter: the people filter, built with AllOf or AnyOf
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) People(filter IDFilter[PersonID]) *DiscoverMoviesBuilder {
+	b.params.WithPeople = filter
+	return b
+}
+
+// OriginCountry filters the movies by their origin country
+//
+// Parameters:
+//
+// - country: the ISO 3166-1 country code
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) OriginCountry(country string) *DiscoverMoviesBuilder {
+	b.params.WithOriginCountry = country
+	return b
+}
+
+// OriginalLanguage filters the movies by their original language
+//
+// Parameters:
+//
+// - language: the ISO 639-1 language code
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) OriginalLanguage(language string) *DiscoverMoviesBuilder {
+	b.params.WithOriginalLanguage = language
+	return b
+}
+
+// ReleaseTypes filters the movies by their release types
+//
+// Parameters:
+//
+// - filter: the release types filter, built with AllOf or AnyOf
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) ReleaseTypes(filter IDFilter[ReleaseTypeEnum]) *DiscoverMoviesBuilder {
+	b.params.WithReleaseType = filter
+	return b
+}
+
+// WatchRegion sets the region used by the watch providers filters
+//
+// Parameters:
+//
+// - region: the ISO 3166-1 region code
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) WatchRegion(region string) *DiscoverMoviesBuilder {
+	b.params.WatchRegion = region
+	return b
+}
+
+// WatchProviders filters the movies by their watch providers in the given region
+//
+// Parameters:
+//
+// - region: the ISO 3166-1 region code
+// - filter: the watch providers filter, built with AllOf or AnyOf
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) WatchProviders(region string, filter IDFilter[WatchProviderID]) *DiscoverMoviesBuilder {
+	b.params.WatchRegion = region
+	b.params.WithWatchProviders = filter
+	return b
+}
+
+// WithoutWatchProviders excludes the movies with the given watch providers
+//
+// Parameters:
+//
+// - filter: the watch providers filter, built with AllOf or AnyOf
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) WithoutWatchProviders(filter IDFilter[WatchProviderID]) *DiscoverMoviesBuilder {
+	b.params.WithoutWatchProviders = filter
+	return b
+}
+
+// WatchMonetizationTypes filters the movies by the monetization types of their watch providers
+//
+// Parameters:
+//
+// - filter: the monetization types filter, built with AllOf or AnyOf
+//
+// Returns:
+//
+// - *DiscoverMoviesBuilder: the builder
+func (b *DiscoverMoviesBuilder) WatchMonetizationTypes(
+	filter IDFilter[WatchMonetizationTypeEnums],
+) *DiscoverMoviesBuilder {
+	b.params.WithWatchMonetizationTypes = filter
+	return b
+}
+
+// Validate validates the query parameters set so far, reporting all the conflicts at once, except for the missing
+// watch region of the watch providers filters, which DiscoverMovies checks once the default watch region is applied
+//
+// Returns:
+//
+// - error: the joined errors for each conflict, or nil if the query parameters are valid
+func (b *DiscoverMoviesBuilder) Validate() error {
+	return b.params.validate(false)
+}
+
+// Build validates and returns the query parameters for discovering movies
+//
+// The watch providers filters may be built without a watch region, since DiscoverMovies applies the default watch
+// region before checking they have one.
+//
+// Returns:
+//
+// - *DiscoverMoviesQueryParameters: the query parameters
+// - error: the joined errors for each conflict, if the query parameters are not valid
+func (b *DiscoverMoviesBuilder) Build() (*DiscoverMoviesQueryParameters, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	params := b.params
+	return &params, nil
+}
@@ -0,0 +1,146 @@
+package gotmdbapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestDiscoverMoviesBuilder tests that the builder only encodes the parameters that were set
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestDiscoverMoviesBuilder(t *testing.T) {
+	params, err := NewDiscoverMovies().
+		Genres(AnyOf[GenreID](28, 12)).
+		VoteAverageBetween(0, 10).
+		ReleasedBetween(NewDate(2020, time.January, 1), Date{}).
+		IncludeAdult(false).
+		Build()
+	if err != nil {
+		t.Fatalf("Build returned unexpected error: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, DiscoverMoviesURL, http.NoBody)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	if err = AddGenreMovieListQueryParameters(req, params); err != nil {
+		t.Fatalf("AddGenreMovieListQueryParameters returned unexpected error: %v", err)
+	}
+
+	// Check the set parameters are encoded, including the zero values
+	q := req.URL.Query()
+	for key, want := range map[string]string{
+		WithGenres:     "28|12",
+		VoteAverageGTE: "0",
+		VoteAverageLTE: "10",
+		ReleaseDateGTE: "2020-01-01",
+		IncludeAdult:   "false",
+	} {
+		if got := q.Get(key); got != want {
+			t.Fatalf("Query parameter %s is %q, expected %q", key, got, want)
+		}
+	}
+
+	// Check the unset parameters are not encoded
+	for _, key := range []string{ReleaseDateLTE, IncludeVideo, Certification, CertificationCountry, Year} {
+		if q.Has(key) {
+			t.Fatalf("Query parameter %s is %q, expected it to be unset", key, q.Get(key))
+		}
+	}
+}
+
+// TestDiscoverMoviesQueryParametersValidate tests that all the conflicts are reported at once
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestDiscoverMoviesQueryParametersValidate(t *testing.T) {
+	err := NewDiscoverMovies().
+		VoteAverageBetween(8, 7).
+		PrimaryReleaseYearBetween(2020, 2010).
+		ReleasedBetween(NewDate(2024, time.February, 1), NewDate(2024, time.January, 1)).
+		Certification("", "PG-13").
+		Genres(AllOf[GenreID](27, 28)).
+		WithoutGenres(AnyOf[GenreID](27)).
+		Page(501).
+		Validate()
+	if !errors.Is(err, ErrInvalidQueryParameter) {
+		t.Fatalf("Validate returned %v, expected %v", err, ErrInvalidQueryParameter)
+	}
+
+	// Check every conflict is reported
+	for _, want := range []string{
+		"vote_average",
+		"primary_release_year",
+		"release_date",
+		Certification,
+		"genres",
+		Page,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("Validate error %q does not report %s", err, want)
+		}
+	}
+
+	// Check valid parameters are accepted
+	if err = NewDiscoverMovies().VoteAverageBetween(7, 10).Validate(); err != nil {
+		t.Fatalf("Validate returned unexpected error: %v", err)
+	}
+}
+
+// TestDiscoverMoviesQueryParametersValidateOrder tests that the conflicts are always reported in the same order
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestDiscoverMoviesQueryParametersValidateOrder(t *testing.T) {
+	params := &DiscoverMoviesQueryParameters{Certification: "PG-13", CertificationGTE: "PG", CertificationLTE: "R"}
+	want := params.Validate().Error()
+	for range 20 {
+		if got := params.Validate().Error(); got != want {
+			t.Fatalf("Validate returned %q, expected %q", got, want)
+		}
+	}
+	if !strings.HasPrefix(want, ErrInvalidQueryParameter.Error()+": "+Certification+" ") {
+		t.Fatalf("Validate returned %q, expected %s to be reported first", want, Certification)
+	}
+}
+
+// TestDiscoverMoviesDefaultWatchRegion tests that the watch providers filters can be built without a watch region,
+// which DiscoverMovies takes from the defaults before checking it is set
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestDiscoverMoviesDefaultWatchRegion(t *testing.T) {
+	params, err := NewDiscoverMovies().WatchMonetizationTypes(AllOf(WatchMonetizationTypeFlatrate)).Build()
+	if err != nil {
+		t.Fatalf("Build returned unexpected error: %v", err)
+	}
+	if err = params.Validate(); !errors.Is(err, ErrInvalidQueryParameter) ||
+		!strings.Contains(err.Error(), WithWatchMonetizationTypes) {
+		t.Fatalf("Validate returned %v, expected the missing %s", err, WatchRegion)
+	}
+
+	// Check the request is only made when the watch region has a default
+	client, requests := newStubbedClient(t, "api-key", http.StatusOK, `{"results":[]}`)
+	if _, _, err = client.DiscoverMovies(context.Background(), params); !errors.Is(err, ErrInvalidQueryParameter) {
+		t.Fatalf("DiscoverMovies returned %v, expected %v", err, ErrInvalidQueryParameter)
+	}
+	if len(*requests) != 0 {
+		t.Fatalf("DiscoverMovies made %d requests, expected none", len(*requests))
+	}
+
+	if _, _, err = client.WithWatchRegion("US").DiscoverMovies(context.Background(), params); err != nil {
+		t.Fatalf("DiscoverMovies returned unexpected error: %v", err)
+	}
+	if got := (*requests)[0].URL.Query().Get(WatchRegion); got != "US" {
+		t.Fatalf("Query parameter %s is %q, expected %q", WatchRegion, got, "US")
+	}
+}
@@ -0,0 +1,211 @@
+package gotmdbapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	// QueryTag is the struct tag used to encode the fields of the query parameter structs, in the form
+	// `tmdb:"name[,omitempty][,or]"`
+	QueryTag = "tmdb"
+
+	// QueryTagOmitEmpty is the struct tag option to omit the zero values, while non-nil pointers are always encoded
+	QueryTagOmitEmpty = "omitempty"
+
+	// QueryTagOr is the struct tag option to join the slice values with OrSeparator instead of AndSeparator
+	QueryTagOr = "or"
+)
+
+type (
+	// queryField is a parsed query struct tag
+	queryField struct {
+		name      string
+		omitEmpty bool
+		separator string
+	}
+)
+
+// parseQueryTag parses a query struct tag
+//
+// Parameters:
+//
+// - tag: the struct tag value
+//
+// Returns:
+//
+// - queryField: the parsed struct tag
+// - bool: false if the field must be skipped
+func parseQueryTag(tag string) (queryField, bool) {
+	name, options, _ := strings.Cut(tag, ",")
+	if name == "" || name == "-" {
+		return queryField{}, false
+	}
+
+	field := queryField{name: name, separator: AndSeparator}
+	for option := range strings.SplitSeq(options, ",") {
+		switch option {
+		case QueryTagOmitEmpty:
+			field.omitEmpty = true
+		case QueryTagOr:
+			field.separator = OrSeparator
+		}
+	}
+	return field, true
+}
+
+// EncodeQueryParameters adds the tagged fields of a query parameter struct to the query parameters
+//
+// Fields are encoded according to their kind: strings and string enums as is, integers and integer enums as numbers,
+// floats in their shortest representation, booleans as true or false, slices joined by their separator, and structs,
+// such as Date and IDFilter, by their String method, where an empty string is never sent. Nil pointers are never sent,
+// and non-nil pointers are always sent, even if they point to a zero value.
+//
+// Parameters:
+//
+// - query: the HTTP request query parameters
+// - params: the query parameter struct, or a pointer to it (optional)
+//
+// Returns:
+//
+// - error: if the params are not a struct or a field has an unsupported type
+func EncodeQueryParameters(query url.Values, params any) error {
+	v := reflect.ValueOf(params)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf(ErrUnsupportedQueryType, ErrQueryEncoding, v.Type(), "params")
+	}
+
+	t := v.Type()
+	for i := range t.NumField() {
+		structField := t.Field(i)
+		field, ok := parseQueryTag(structField.Tag.Get(QueryTag))
+		if !ok || !structField.IsExported() {
+			continue
+		}
+
+		value, ok, err := encodeQueryValue(v.Field(i), field)
+		if err != nil {
+			return err
+		}
+		if ok {
+			query.Add(field.name, value)
+		}
+	}
+	return nil
+}
+
+// AddQueryParameters adds the tagged fields of a query parameter struct to the HTTP request
+//
+// Parameters:
+//
+// - req: the HTTP request
+// - params: the query parameter struct, or a pointer to it (optional)
+//
+// Returns:
+//
+// - error: if the params are not a struct or a field has an unsupported type
+func AddQueryParameters(req *http.Request, params any) error {
+	q := req.URL.Query()
+	if err := EncodeQueryParameters(q, params); err != nil {
+		return err
+	}
+	req.URL.RawQuery = q.Encode()
+	return nil
+}
+
+// encodeQueryValue encodes a field value as a query parameter value
+//
+// Parameters:
+//
+// - v: the field value
+// - field: the parsed struct tag of the field
+//
+// Returns:
+//
+// - string: the encoded value
+// - bool: false if the value must not be sent
+// - error: if the field has an unsupported type
+func encodeQueryValue(v reflect.Value, field queryField) (string, bool, error) {
+	// Non-nil pointers are always sent, since they are used to express optional zero values
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", false, nil
+		}
+		value, ok := formatQueryScalar(v.Elem())
+		if !ok {
+			return "", false, fmt.Errorf(ErrUnsupportedQueryType, ErrQueryEncoding, v.Type(), field.name)
+		}
+		return value, true, nil
+	}
+
+	// Join the slices with the field separator
+	if v.Kind() == reflect.Slice {
+		if v.Len() == 0 {
+			return "", false, nil
+		}
+		values := make([]string, v.Len())
+		for i := range v.Len() {
+			value, ok := formatQueryScalar(v.Index(i))
+			if !ok {
+				return "", false, fmt.Errorf(ErrUnsupportedQueryType, ErrQueryEncoding, v.Type(), field.name)
+			}
+			values[i] = value
+		}
+		return strings.Join(values, field.separator), true, nil
+	}
+
+	value, ok := formatQueryScalar(v)
+	if !ok {
+		return "", false, fmt.Errorf(ErrUnsupportedQueryType, ErrQueryEncoding, v.Type(), field.name)
+	}
+
+	// Structs formatted as an empty string, such as the zero Date or an empty IDFilter, are never sent
+	if v.Kind() == reflect.Struct && value == "" {
+		return "", false, nil
+	}
+	if field.omitEmpty && v.IsZero() {
+		return "", false, nil
+	}
+	return value, true, nil
+}
+
+// formatQueryScalar formats a scalar value as a query parameter value
+//
+// Parameters:
+//
+// - v: the scalar value
+//
+// Returns:
+//
+// - string: the formatted value
+// - bool: false if the value has an unsupported type
+func formatQueryScalar(v reflect.Value) (string, bool) {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), true
+	case reflect.Struct:
+		if stringer, ok := v.Interface().(fmt.Stringer); ok {
+			return stringer.String(), true
+		}
+	default:
+	}
+	return "", false
+}
@@ -0,0 +1,75 @@
+package gotmdbapi
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// TestEncodeQueryParameters tests the encoding of the supported field kinds
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestEncodeQueryParameters(t *testing.T) {
+	params := struct {
+		Name        string            `tmdb:"name,omitempty"`
+		Empty       string            `tmdb:"empty,omitempty"`
+		Page        int32             `tmdb:"page,omitempty"`
+		Adult       bool              `tmdb:"include_adult"`
+		Score       *float32          `tmdb:"score"`
+		Unset       *int32            `tmdb:"unset"`
+		Since       Date              `tmdb:"since"`
+		Types       []ReleaseTypeEnum `tmdb:"types,or"`
+		Genres      IDFilter[GenreID] `tmdb:"genres"`
+		NoGenres    IDFilter[GenreID] `tmdb:"no_genres"`
+		Skipped     string            `tmdb:"-"`
+		SortBy      SortByEnum        `tmdb:"sort_by,omitempty"`
+		notExported string            `tmdb:"not_exported"`
+		WithoutTag  string
+	}{
+		Name:        "name",
+		Score:       ptr[float32](7.5),
+		Since:       NewDate(2024, time.March, 9),
+		Types:       []ReleaseTypeEnum{ReleaseTypeTheatrical, ReleaseTypeDigital},
+		Genres:      AllOf[GenreID](28, 12),
+		Skipped:     "skipped",
+		notExported: "not exported",
+		WithoutTag:  "without tag",
+	}
+
+	query := url.Values{}
+	if err := EncodeQueryParameters(query, &params); err != nil {
+		t.Fatalf("EncodeQueryParameters returned unexpected error: %v", err)
+	}
+
+	want := url.Values{
+		"name":          {"name"},
+		"include_adult": {"false"},
+		"score":         {"7.5"},
+		"since":         {"2024-03-09"},
+		"types":         {"3|4"},
+		"genres":        {"28,12"},
+	}
+	if got, expected := query.Encode(), want.Encode(); got != expected {
+		t.Fatalf("EncodeQueryParameters encoded %q, expected %q", got, expected)
+	}
+}
+
+// TestEncodeQueryParametersUnsupportedType tests that unsupported field types are reported
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestEncodeQueryParametersUnsupportedType(t *testing.T) {
+	params := struct {
+		Values map[string]string `tmdb:"values"`
+	}{}
+	if err := EncodeQueryParameters(url.Values{}, params); !errors.Is(err, ErrQueryEncoding) {
+		t.Fatalf("EncodeQueryParameters returned %v, expected %v", err, ErrQueryEncoding)
+	}
+	if err := EncodeQueryParameters(url.Values{}, "params"); !errors.Is(err, ErrQueryEncoding) {
+		t.Fatalf("EncodeQueryParameters returned %v, expected %v", err, ErrQueryEncoding)
+	}
+}
@@ -1,11 +1,54 @@
 package gotmdbapi
 
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
 type (
 	// SortByEnum represents the sorting options for TMDB API requests
 	SortByEnum string
 
 	// WatchMonetizationTypeEnums represents the watch monetization types for TMDB API requests
 	WatchMonetizationTypeEnums string
+
+	// ChangeActionEnum represents the action of a change item returned by the TMDB API
+	ChangeActionEnum string
+
+	// MediaTypeEnum represents the media types for TMDB API requests
+	MediaTypeEnum string
+
+	// AccountSortByEnum represents the sorting options for TMDB API account lists
+	AccountSortByEnum string
+
+	// V4ListSortByEnum represents the sorting options for TMDB API v4 lists
+	V4ListSortByEnum string
+
+	// EpisodeGroupTypeEnum represents the types of TV episode groups
+	EpisodeGroupTypeEnum int32
+
+	// GenderEnum represents the gender of a person, where unknown values are preserved when decoding
+	GenderEnum int32
+
+	// DepartmentEnum represents the department of a crew member, where unknown values are preserved when decoding
+	DepartmentEnum string
+
+	// MovieStatusEnum represents the release status of a movie, where unknown values are preserved when decoding
+	MovieStatusEnum string
+
+	// VideoTypeEnum represents the type of a video, where unknown values are preserved when decoding
+	VideoTypeEnum string
+
+	// VideoSiteEnum represents the site hosting a video, where unknown values are preserved when decoding
+	VideoSiteEnum string
+
+	// ReleaseTypeEnum represents the type of a movie release, where unknown values are preserved when decoding
+	ReleaseTypeEnum int32
+
+	// ImageSizeEnum represents the sizes of the TMDB images, where the sizes supported by each image type are listed by
+	// the TMDB API configuration
+	ImageSizeEnum string
 )
 
 const (
@@ -32,3 +75,363 @@ const (
 	WatchMonetizationTypeRent     WatchMonetizationTypeEnums = "rent"
 	WatchMonetizationTypeBuy      WatchMonetizationTypeEnums = "buy"
 )
+
+const (
+	ImageSizeW45      ImageSizeEnum = "w45"
+	ImageSizeW92      ImageSizeEnum = "w92"
+	ImageSizeW154     ImageSizeEnum = "w154"
+	ImageSizeW185     ImageSizeEnum = "w185"
+	ImageSizeW300     ImageSizeEnum = "w300"
+	ImageSizeW342     ImageSizeEnum = "w342"
+	ImageSizeW500     ImageSizeEnum = "w500"
+	ImageSizeW780     ImageSizeEnum = "w780"
+	ImageSizeW1280    ImageSizeEnum = "w1280"
+	ImageSizeH632     ImageSizeEnum = "h632"
+	ImageSizeOriginal ImageSizeEnum = "original"
+)
+
+const (
+	ChangeActionAdded   ChangeActionEnum = "added"
+	ChangeActionCreated ChangeActionEnum = "created"
+	ChangeActionUpdated ChangeActionEnum = "updated"
+	ChangeActionDeleted ChangeActionEnum = "deleted"
+)
+
+const (
+	MediaTypeMovie MediaTypeEnum = "movie"
+	MediaTypeTV    MediaTypeEnum = "tv"
+)
+
+const (
+	AccountSortByCreatedAtAsc  AccountSortByEnum = "created_at.asc"
+	AccountSortByCreatedAtDesc AccountSortByEnum = "created_at.desc"
+)
+
+const (
+	V4ListSortByOriginalOrderAsc       V4ListSortByEnum = "original_order.asc"
+	V4ListSortByOriginalOrderDesc      V4ListSortByEnum = "original_order.desc"
+	V4ListSortByPrimaryReleaseDateAsc  V4ListSortByEnum = "primary_release_date.asc"
+	V4ListSortByPrimaryReleaseDateDesc V4ListSortByEnum = "primary_release_date.desc"
+	V4ListSortByTitleAsc               V4ListSortByEnum = "title.asc"
+	V4ListSortByTitleDesc              V4ListSortByEnum = "title.desc"
+	V4ListSortByVoteAverageAsc         V4ListSortByEnum = "vote_average.asc"
+	V4ListSortByVoteAverageDesc        V4ListSortByEnum = "vote_average.desc"
+)
+
+const (
+	EpisodeGroupTypeOriginalAirDate EpisodeGroupTypeEnum = 1
+	EpisodeGroupTypeAbsolute        EpisodeGroupTypeEnum = 2
+	EpisodeGroupTypeDVD             EpisodeGroupTypeEnum = 3
+	EpisodeGroupTypeDigital         EpisodeGroupTypeEnum = 4
+	EpisodeGroupTypeStoryArc        EpisodeGroupTypeEnum = 5
+	EpisodeGroupTypeProduction      EpisodeGroupTypeEnum = 6
+	EpisodeGroupTypeTV              EpisodeGroupTypeEnum = 7
+)
+
+const (
+	GenderNotSet    GenderEnum = 0
+	GenderFemale    GenderEnum = 1
+	GenderMale      GenderEnum = 2
+	GenderNonBinary GenderEnum = 3
+)
+
+const (
+	DepartmentActing        DepartmentEnum = "Acting"
+	DepartmentArt           DepartmentEnum = "Art"
+	DepartmentCamera        DepartmentEnum = "Camera"
+	DepartmentCostumeMakeUp DepartmentEnum = "Costume & Make-Up"
+	DepartmentCreator       DepartmentEnum = "Creator"
+	DepartmentCrew          DepartmentEnum = "Crew"
+	DepartmentDirecting     DepartmentEnum = "Directing"
+	DepartmentEditing       DepartmentEnum = "Editing"
+	DepartmentLighting      DepartmentEnum = "Lighting"
+	DepartmentProduction    DepartmentEnum = "Production"
+	DepartmentSound         DepartmentEnum = "Sound"
+	DepartmentVisualEffects DepartmentEnum = "Visual Effects"
+	DepartmentWriting       DepartmentEnum = "Writing"
+)
+
+const (
+	MovieStatusRumored        MovieStatusEnum = "Rumored"
+	MovieStatusPlanned        MovieStatusEnum = "Planned"
+	MovieStatusInProduction   MovieStatusEnum = "In Production"
+	MovieStatusPostProduction MovieStatusEnum = "Post Production"
+	MovieStatusReleased       MovieStatusEnum = "Released"
+	MovieStatusCanceled       MovieStatusEnum = "Canceled"
+)
+
+const (
+	VideoTypeTrailer         VideoTypeEnum = "Trailer"
+	VideoTypeTeaser          VideoTypeEnum = "Teaser"
+	VideoTypeClip            VideoTypeEnum = "Clip"
+	VideoTypeFeaturette      VideoTypeEnum = "Featurette"
+	VideoTypeBehindTheScenes VideoTypeEnum = "Behind the Scenes"
+	VideoTypeBloopers        VideoTypeEnum = "Bloopers"
+	VideoTypeOpeningCredits  VideoTypeEnum = "Opening Credits"
+)
+
+const (
+	VideoSiteYouTube VideoSiteEnum = "YouTube"
+	VideoSiteVimeo   VideoSiteEnum = "Vimeo"
+)
+
+const (
+	ReleaseTypePremiere          ReleaseTypeEnum = 1
+	ReleaseTypeTheatricalLimited ReleaseTypeEnum = 2
+	ReleaseTypeTheatrical        ReleaseTypeEnum = 3
+	ReleaseTypeDigital           ReleaseTypeEnum = 4
+	ReleaseTypePhysical          ReleaseTypeEnum = 5
+	ReleaseTypeTV                ReleaseTypeEnum = 6
+)
+
+var (
+	// genderNames maps the known genders to their names
+	genderNames = map[GenderEnum]string{
+		GenderNotSet:    "Not set",
+		GenderFemale:    "Female",
+		GenderMale:      "Male",
+		GenderNonBinary: "Non-binary",
+	}
+
+	// releaseTypeNames maps the known release types to their names
+	releaseTypeNames = map[ReleaseTypeEnum]string{
+		ReleaseTypePremiere:          "Premiere",
+		ReleaseTypeTheatricalLimited: "Theatrical (limited)",
+		ReleaseTypeTheatrical:        "Theatrical",
+		ReleaseTypeDigital:           "Digital",
+		ReleaseTypePhysical:          "Physical",
+		ReleaseTypeTV:                "TV",
+	}
+
+	// knownDepartments is the set of known departments
+	knownDepartments = map[DepartmentEnum]struct{}{
+		DepartmentActing:        {},
+		DepartmentArt:           {},
+		DepartmentCamera:        {},
+		DepartmentCostumeMakeUp: {},
+		DepartmentCreator:       {},
+		DepartmentCrew:          {},
+		DepartmentDirecting:     {},
+		DepartmentEditing:       {},
+		DepartmentLighting:      {},
+		DepartmentProduction:    {},
+		DepartmentSound:         {},
+		DepartmentVisualEffects: {},
+		DepartmentWriting:       {},
+	}
+
+	// knownMovieStatuses is the set of known movie statuses
+	knownMovieStatuses = map[MovieStatusEnum]struct{}{
+		MovieStatusRumored:        {},
+		MovieStatusPlanned:        {},
+		MovieStatusInProduction:   {},
+		MovieStatusPostProduction: {},
+		MovieStatusReleased:       {},
+		MovieStatusCanceled:       {},
+	}
+
+	// knownVideoTypes is the set of known video types
+	knownVideoTypes = map[VideoTypeEnum]struct{}{
+		VideoTypeTrailer:         {},
+		VideoTypeTeaser:          {},
+		VideoTypeClip:            {},
+		VideoTypeFeaturette:      {},
+		VideoTypeBehindTheScenes: {},
+		VideoTypeBloopers:        {},
+		VideoTypeOpeningCredits:  {},
+	}
+
+	// knownVideoSites is the set of known video sites
+	knownVideoSites = map[VideoSiteEnum]struct{}{
+		VideoSiteYouTube: {},
+		VideoSiteVimeo:   {},
+	}
+)
+
+// unmarshalIntEnum unmarshals an integer enum value, which TMDB may encode either as a number, a quoted number or null
+//
+// Parameters:
+//
+// - data: the JSON data
+//
+// Returns:
+//
+// - int32: the enum value, or 0 if the value is null
+// - error: if the value is not an integer
+func unmarshalIntEnum(data []byte) (int32, error) {
+	if string(data) == "null" {
+		return 0, nil
+	}
+
+	// Check if the value is encoded as a string
+	var quoted string
+	if err := json.Unmarshal(data, &quoted); err == nil {
+		value, parseErr := strconv.ParseInt(quoted, 10, 32)
+		if parseErr != nil {
+			return 0, parseErr
+		}
+		return int32(value), nil
+	}
+
+	var value int32
+	if err := json.Unmarshal(data, &value); err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// String returns the name of the gender
+//
+// Returns:
+//
+// - string: the name of the gender, or its numeric value if unknown
+func (g GenderEnum) String() string {
+	if name, ok := genderNames[g]; ok {
+		return name
+	}
+	return fmt.Sprintf("GenderEnum(%d)", int32(g))
+}
+
+// IsKnown returns whether the gender is one of the known values
+//
+// Returns:
+//
+// - bool: true if the gender is known
+func (g GenderEnum) IsKnown() bool {
+	_, ok := genderNames[g]
+	return ok
+}
+
+// UnmarshalJSON unmarshals the gender, preserving unknown values
+//
+// Parameters:
+//
+// - data: the JSON data
+//
+// Returns:
+//
+// - error: if the gender is not an integer
+func (g *GenderEnum) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalIntEnum(data)
+	if err != nil {
+		return err
+	}
+	*g = GenderEnum(value)
+	return nil
+}
+
+// String returns the name of the release type
+//
+// Returns:
+//
+// - string: the name of the release type, or its numeric value if unknown
+func (r ReleaseTypeEnum) String() string {
+	if name, ok := releaseTypeNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("ReleaseTypeEnum(%d)", int32(r))
+}
+
+// IsKnown returns whether the release type is one of the known values
+//
+// Returns:
+//
+// - bool: true if the release type is known
+func (r ReleaseTypeEnum) IsKnown() bool {
+	_, ok := releaseTypeNames[r]
+	return ok
+}
+
+// UnmarshalJSON unmarshals the release type, preserving unknown values
+//
+// Parameters:
+//
+// - data: the JSON data
+//
+// Returns:
+//
+// - error: if the release type is not an integer
+func (r *ReleaseTypeEnum) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalIntEnum(data)
+	if err != nil {
+		return err
+	}
+	*r = ReleaseTypeEnum(value)
+	return nil
+}
+
+// String returns the department as a string
+//
+// Returns:
+//
+// - string: the department
+func (d DepartmentEnum) String() string {
+	return string(d)
+}
+
+// IsKnown returns whether the department is one of the known values
+//
+// Returns:
+//
+// - bool: true if the department is known
+func (d DepartmentEnum) IsKnown() bool {
+	_, ok := knownDepartments[d]
+	return ok
+}
+
+// String returns the movie status as a string
+//
+// Returns:
+//
+// - string: the movie status
+func (m MovieStatusEnum) String() string {
+	return string(m)
+}
+
+// IsKnown returns whether the movie status is one of the known values
+//
+// Returns:
+//
+// - bool: true if the movie status is known
+func (m MovieStatusEnum) IsKnown() bool {
+	_, ok := knownMovieStatuses[m]
+	return ok
+}
+
+// String returns the video type as a string
+//
+// Returns:
+//
+// - string: the video type
+func (v VideoTypeEnum) String() string {
+	return string(v)
+}
+
+// IsKnown returns whether the video type is one of the known values
+//
+// Returns:
+//
+// - bool: true if the video type is known
+func (v VideoTypeEnum) IsKnown() bool {
+	_, ok := knownVideoTypes[v]
+	return ok
+}
+
+// String returns the video site as a string
+//
+// Returns:
+//
+// - string: the video site
+func (v VideoSiteEnum) String() string {
+	return string(v)
+}
+
+// IsKnown returns whether the video site is one of the known values
+//
+// Returns:
+//
+// - bool: true if the video site is known
+func (v VideoSiteEnum) IsKnown() bool {
+	_, ok := knownVideoSites[v]
+	return ok
+}
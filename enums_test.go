@@ -0,0 +1,50 @@
+package gotmdbapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestEnumsUnmarshalUnknownValues tests that unknown enum values are preserved when decoding
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestEnumsUnmarshalUnknownValues(t *testing.T) {
+	data := `{"gender":9,"known_for_department":"Acting","department":"Puppetry","name":"Jim"}`
+	var crew Crew
+	if err := json.Unmarshal([]byte(data), &crew); err != nil {
+		t.Fatalf("Failed to unmarshal crew: %v", err)
+	}
+
+	// Check the known values
+	if crew.KnownForDepartment != DepartmentActing || !crew.KnownForDepartment.IsKnown() {
+		t.Fatalf("Unexpected known for department %q", crew.KnownForDepartment)
+	}
+
+	// Check the unknown values are preserved
+	if crew.Gender != 9 || crew.Gender.IsKnown() {
+		t.Fatalf("Unexpected gender %s", crew.Gender)
+	}
+	if crew.Department != "Puppetry" || crew.Department.IsKnown() {
+		t.Fatalf("Unexpected department %q", crew.Department)
+	}
+	if got := crew.Gender.String(); got != "GenderEnum(9)" {
+		t.Fatalf("Gender.String returned %q", got)
+	}
+}
+
+// TestReleaseTypeEnumUnmarshalJSON tests the decoding of release types encoded as numbers or quoted numbers
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestReleaseTypeEnumUnmarshalJSON(t *testing.T) {
+	var releaseTypes []ReleaseTypeEnum
+	if err := json.Unmarshal([]byte(`[3,"4",null]`), &releaseTypes); err != nil {
+		t.Fatalf("Failed to unmarshal release types: %v", err)
+	}
+	if releaseTypes[0] != ReleaseTypeTheatrical || releaseTypes[1] != ReleaseTypeDigital || releaseTypes[2] != 0 {
+		t.Fatalf("Unexpected release types %v", releaseTypes)
+	}
+}
@@ -8,10 +8,47 @@ const (
 	ErrBuildingRequest           = "error building TMDB API request: %v"
 	ErrAnErrOcurredDuringRequest = "an error occurred during the TMDB API request: %v"
 	ErrRequestFailed             = "TMDB API request failed with status code %d: %s"
+	ErrInvalidDate               = "invalid date %q, expected YYYY-MM-DD format"
+	ErrUnsuccessfulResponse      = "TMDB API request was not successful with status code %d: %s"
+	ErrInvertedRange             = "%w: %s has a lower bound %v greater than its upper bound %v"
+	ErrValueOutOfRange           = "%w: %s must be between %v and %v, got %v"
+	ErrValueBelowMinimum         = "%w: %s must be at least %v, got %v"
+	ErrMissingDependency         = "%w: %s requires %s to be set"
+	ErrUnsupportedQueryType      = "%w: unsupported type %s of query parameter %s"
+	ErrUnsupportedType           = "%w: unsupported type %s"
+	ErrConflictingFilter         = "%w: %s includes and excludes the same IDs %s"
+	ErrInvalidQueryValue         = "%s=%q: %v"
 )
 
 var (
-	ErrNilClient       = errors.New("TMDB API client is nil")
-	ErrEmptyAPIKey     = errors.New("TMDB API key is nil or empty")
-	ErrResponseParsing = errors.New("failed to parse TMDB API response")
+	ErrNilClient              = errors.New("TMDB API client is nil")
+	ErrEmptyAPIKey            = errors.New("TMDB API key is nil or empty")
+	ErrResponseParsing        = errors.New("failed to parse TMDB API response")
+	ErrEmptyRequestToken      = errors.New("TMDB API request token is empty")
+	ErrEmptyAccessToken       = errors.New("TMDB API access token is empty")
+	ErrNilSession             = errors.New("TMDB API session is nil")
+	ErrGuestSessionNotAllowed = errors.New("TMDB API guest sessions are not allowed for this request")
+	ErrInvalidMediaType       = errors.New("TMDB API media type must be either movie or tv")
+	ErrInvalidRating          = errors.New("TMDB API rating must be between 0.5 and 10.0 in steps of 0.5")
+	ErrEmptyListID            = errors.New("TMDB API list ID is empty")
+	ErrEmptyListName          = errors.New("TMDB API list name is empty")
+	ErrEmptyAccountID         = errors.New("TMDB API account ID is empty")
+	ErrEmptyListItems         = errors.New("TMDB API list items are empty")
+	ErrNilRequestBody         = errors.New("TMDB API request body is nil")
+	ErrEmptyReviewID          = errors.New("TMDB API review ID is empty")
+	ErrEmptyCreditID          = errors.New("TMDB API credit ID is empty")
+	ErrEmptyEpisodeGroupID    = errors.New("TMDB API episode group ID is empty")
+	ErrInvalidQueryParameter  = errors.New("TMDB API query parameter is invalid")
+	ErrQueryEncoding          = errors.New("failed to encode TMDB API query parameters")
+	ErrQueryDecoding          = errors.New("failed to decode TMDB API query parameters")
+	ErrRepeatedQueryParameter = errors.New("TMDB API query parameter is repeated")
+	ErrMixedIDFilter          = errors.New("TMDB API ID filter mixes AND (,) and OR (|) separators")
+	ErrInvalidChangesWindow   = errors.New(
+		"TMDB API changes window must have an end date after the start date and span at most 14 days",
+	)
+	ErrNilCredentialProvider     = errors.New("TMDB API credential provider is nil")
+	ErrNoAvailableAPIKey         = errors.New("TMDB API keys are all quarantined")
+	ErrV4RequiresReadAccessToken = errors.New(
+		"TMDB API v4 requires a read access token or user access token, not a v3 API key",
+	)
 )
@@ -0,0 +1,238 @@
+package gotmdbapi
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const (
+	// fallbackConcurrency is the maximum number of translations fetched at a time to fill the movies of a list
+	fallbackConcurrency = 4
+)
+
+type (
+	// localizedField is a localized field of a movie to be filled by the language fallback chain
+	localizedField struct {
+		value    *string
+		language *string
+		pick     func(data MovieTranslationData) string
+	}
+)
+
+// Language returns the language tag of the translation, such as pt-BR
+//
+// Returns:
+//
+// - string: the language tag
+func (t MovieTranslation) Language() string {
+	if t.ISO3166_1 == "" {
+		return t.ISO639_1
+	}
+	return t.ISO639_1 + "-" + t.ISO3166_1
+}
+
+// Find finds the translation for the given language
+//
+// Parameters:
+//
+// - language: the language tag, such as pt-BR, or a language without region, such as pt, to match any region
+//
+// Returns:
+//
+// - *MovieTranslation: the translation, or nil if there is no translation for the language
+func (r MovieTranslationsResponse) Find(language string) *MovieTranslation {
+	code, region, hasRegion := strings.Cut(language, "-")
+	for i := range r.Translations {
+		t := &r.Translations[i]
+		if !strings.EqualFold(t.ISO639_1, code) {
+			continue
+		}
+		if !hasRegion || strings.EqualFold(t.ISO3166_1, region) {
+			return t
+		}
+	}
+	return nil
+}
+
+// fillLocalizedFields fills the empty localized fields from the first language of the fallback chain that has them
+//
+// Parameters:
+//
+// - translations: the translations of the movie
+// - chain: the language fallback chain
+// - fields: the localized fields
+func fillLocalizedFields(translations *MovieTranslationsResponse, chain []string, fields ...localizedField) {
+	for _, field := range fields {
+		if *field.value != "" {
+			continue
+		}
+		for _, language := range chain {
+			translation := translations.Find(language)
+			if translation == nil {
+				continue
+			}
+			if value := field.pick(translation.Data); value != "" {
+				*field.value = value
+				*field.language = translation.Language()
+				break
+			}
+		}
+	}
+}
+
+// requestedLanguage returns the language requested to the TMDB API
+//
+// Parameters:
+//
+// - req: the HTTP request
+//
+// Returns:
+//
+// - string: the language query parameter, or DefaultLanguage if it is not set
+func requestedLanguage(req *http.Request) string {
+	if language := req.URL.Query().Get(Language); language != "" {
+		return language
+	}
+	return DefaultLanguage
+}
+
+// recordLanguage records the requested language for a localized field, if the field is not empty
+//
+// Parameters:
+//
+// - value: the localized field
+// - language: the requested language
+//
+// Returns:
+//
+// - string: the requested language, or an empty string if the field is empty
+func recordLanguage(value, language string) string {
+	if value == "" {
+		return ""
+	}
+	return language
+}
+
+// applyMovieDetailsFallback records the language of the localized fields of the movie details and fills the empty ones
+// from the language fallback chain, on a best-effort basis, so the fields whose translations could not be fetched are
+// left empty
+//
+// Parameters:
+//
+// - req: the HTTP request of the movie details
+// - movie: the movie details
+func (c Client) applyMovieDetailsFallback(req *http.Request, movie *MovieDetailsResponse) {
+	var tagline string
+	if movie.Tagline != nil {
+		tagline = *movie.Tagline
+	}
+
+	// Record the requested language for the fields that are already localized
+	language := requestedLanguage(req)
+	movie.FieldLanguages = FieldLanguages{
+		Title:    recordLanguage(movie.Title, language),
+		Overview: recordLanguage(movie.Overview, language),
+		Tagline:  recordLanguage(tagline, language),
+	}
+
+	chain := c.resolveDefaults(req.Context()).FallbackLanguages
+	if len(chain) == 0 || (movie.Title != "" && movie.Overview != "" && tagline != "") {
+		return
+	}
+
+	// Fill the empty fields from the translations
+	translations, _, err := c.GetMovieTranslations(withoutResponseMeta(req.Context()), movie.ID)
+	if err != nil {
+		return
+	}
+	fillLocalizedFields(
+		translations,
+		chain,
+		localizedField{
+			value:    &movie.Title,
+			language: &movie.FieldLanguages.Title,
+			pick:     func(data MovieTranslationData) string { return data.Title },
+		},
+		localizedField{
+			value:    &movie.Overview,
+			language: &movie.FieldLanguages.Overview,
+			pick:     func(data MovieTranslationData) string { return data.Overview },
+		},
+		localizedField{
+			value:    &tagline,
+			language: &movie.FieldLanguages.Tagline,
+			pick:     func(data MovieTranslationData) string { return data.Tagline },
+		},
+	)
+	if tagline != "" {
+		movie.Tagline = &tagline
+	}
+}
+
+// applyMoviesFallback records the language of the localized fields of the movies and fills the empty ones from the
+// language fallback chain, on a best-effort basis, when the list fallback is enabled, fetching the translations of at
+// most ListFallbackLimit movies with empty fields, up to fallbackConcurrency at a time
+//
+// Parameters:
+//
+// - req: the HTTP request of the movies
+// - movies: the movies
+func (c Client) applyMoviesFallback(req *http.Request, movies []SimpleMovie) {
+	language := requestedLanguage(req)
+	defaults := c.resolveDefaults(req.Context())
+
+	// Record the requested language and collect the movies with empty fields, up to the limit
+	var pending []*SimpleMovie
+	for i := range movies {
+		movie := &movies[i]
+		movie.FieldLanguages = FieldLanguages{
+			Title:    recordLanguage(movie.Title, language),
+			Overview: recordLanguage(movie.Overview, language),
+		}
+		if movie.Title == "" || movie.Overview == "" {
+			pending = append(pending, movie)
+		}
+	}
+	if len(defaults.FallbackLanguages) == 0 || defaults.ListFallbackLimit <= 0 {
+		return
+	}
+	if len(pending) > defaults.ListFallbackLimit {
+		pending = pending[:defaults.ListFallbackLimit]
+	}
+
+	// Fill the empty fields from the translations, each movie being filled by a single goroutine
+	ctx := withoutResponseMeta(req.Context())
+	semaphore := make(chan struct{}, fallbackConcurrency)
+	var wg sync.WaitGroup
+	for _, movie := range pending {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			translations, _, err := c.GetMovieTranslations(ctx, movie.ID)
+			if err != nil {
+				return
+			}
+			fillLocalizedFields(
+				translations,
+				defaults.FallbackLanguages,
+				localizedField{
+					value:    &movie.Title,
+					language: &movie.FieldLanguages.Title,
+					pick:     func(data MovieTranslationData) string { return data.Title },
+				},
+				localizedField{
+					value:    &movie.Overview,
+					language: &movie.FieldLanguages.Overview,
+					pick:     func(data MovieTranslationData) string { return data.Overview },
+				},
+			)
+		}()
+	}
+	wg.Wait()
+}
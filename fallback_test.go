@@ -0,0 +1,124 @@
+package gotmdbapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// TestFillLocalizedFields tests that the empty localized fields are filled following the language fallback chain
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestFillLocalizedFields(t *testing.T) {
+	translations := &MovieTranslationsResponse{
+		Translations: []MovieTranslation{
+			{ISO639_1: "pt", ISO3166_1: "BR", Data: MovieTranslationData{Title: "Clube da Luta"}},
+			{ISO639_1: "pt", ISO3166_1: "PT", Data: MovieTranslationData{Overview: "Um empregado de escritório..."}},
+			{
+				ISO639_1:  "en",
+				ISO3166_1: "US",
+				Data:      MovieTranslationData{Overview: "A ticking-time-bomb insomniac...", Tagline: "Mischief."},
+			},
+		},
+	}
+
+	title, overview, tagline := "Clube da Luta", "", ""
+	languages := FieldLanguages{Title: "pt-BR"}
+	fillLocalizedFields(
+		translations,
+		[]string{"pt-PT", "en"},
+		localizedField{
+			value:    &title,
+			language: &languages.Title,
+			pick:     func(data MovieTranslationData) string { return data.Title },
+		},
+		localizedField{
+			value:    &overview,
+			language: &languages.Overview,
+			pick:     func(data MovieTranslationData) string { return data.Overview },
+		},
+		localizedField{
+			value:    &tagline,
+			language: &languages.Tagline,
+			pick:     func(data MovieTranslationData) string { return data.Tagline },
+		},
+	)
+
+	if overview != "Um empregado de escritório..." || tagline != "Mischief." {
+		t.Fatalf("fillLocalizedFields filled overview %q and tagline %q", overview, tagline)
+	}
+	want := FieldLanguages{Title: "pt-BR", Overview: "pt-PT", Tagline: "en-US"}
+	if languages != want {
+		t.Fatalf("fillLocalizedFields recorded %+v, expected %+v", languages, want)
+	}
+}
+
+// TestListFallbackLimit tests that the list responses are only filled from the language fallback chain when the list
+// fallback is enabled, fetching at most the translations of the limit of movies
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestListFallbackLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		clientLimit int
+		callLimit   int
+		fetches     int32
+		overviews   []string
+	}{
+		{name: "disabled by default", overviews: []string{"", "", ""}},
+		{name: "limited", clientLimit: 2, fetches: 2, overviews: []string{"Overview", "Overview", ""}},
+		{name: "disabled per call", clientLimit: 2, callLimit: -1, overviews: []string{"", "", ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client, err := NewClient("api-key")
+				if err != nil {
+					t.Fatalf("Failed to create client: %v", err)
+				}
+
+				// Stub the TMDB API, answering the movies without overviews
+				var fetches atomic.Int32
+				client = client.WithFallbackLanguages("en").WithListFallbackLimit(tt.clientLimit).WithHTTPClient(
+					DoerFunc(
+						func(req *http.Request) (*http.Response, error) {
+							body := `{"results":[{"id":1,"title":"A"},{"id":2,"title":"B"},{"id":3,"title":"C"}]}`
+							if strings.HasSuffix(req.URL.Path, "/translations") {
+								fetches.Add(1)
+								body = `{"translations":[{"iso_639_1":"en","data":{"overview":"Overview"}}]}`
+							}
+							return &http.Response{
+								StatusCode: http.StatusOK,
+								Body:       io.NopCloser(strings.NewReader(body)),
+								Request:    req,
+							}, nil
+						},
+					),
+				)
+
+				ctx := ContextWithDefaults(context.Background(), Defaults{ListFallbackLimit: tt.callLimit})
+				response, _, err := client.GetMoviesPopular(ctx, "es", 1, "")
+				if err != nil {
+					t.Fatalf("GetMoviesPopular() failed: %v", err)
+				}
+
+				if fetches.Load() != tt.fetches {
+					t.Errorf("fetched %d translations, expected %d", fetches.Load(), tt.fetches)
+				}
+				for i, movie := range response.Results {
+					if movie.Overview != tt.overviews[i] {
+						t.Errorf("movie %d has overview %q, expected %q", movie.ID, movie.Overview, tt.overviews[i])
+					}
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,69 @@
+package gotmdbapi
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	// testV3APIKey is a TMDB API v3 key used by the tests
+	testV3APIKey = "0123456789abcdef0123456789ABCDEF"
+)
+
+// stubbedRequest represents a request sent to a stubbed TMDB API
+type stubbedRequest struct {
+	Method string
+	URL    *url.URL
+	Header http.Header
+	Body   string
+}
+
+// newStubbedClient creates a TMDB API client whose requests are recorded and answered with the given response
+//
+// Parameters:
+//
+// - t: the testing.T instance
+// - apiKey: the TMDB API key
+// - statusCode: the HTTP status code of the responses
+// - body: the JSON body of the responses
+//
+// Returns:
+//
+// - *Client: the TMDB API client
+// - *[]stubbedRequest: the recorded requests
+func newStubbedClient(t *testing.T, apiKey string, statusCode int, body string) (*Client, *[]stubbedRequest) {
+	client, err := NewClient(apiKey)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	var mu sync.Mutex
+	var requests []stubbedRequest
+	client = client.WithHTTPClient(
+		DoerFunc(
+			func(req *http.Request) (*http.Response, error) {
+				reqBody, err := io.ReadAll(req.Body)
+				if err != nil {
+					t.Errorf("Failed to read request body: %v", err)
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+				requests = append(
+					requests,
+					stubbedRequest{Method: req.Method, URL: req.URL, Header: req.Header, Body: string(reqBody)},
+				)
+				return &http.Response{
+					StatusCode: statusCode,
+					Body:       io.NopCloser(strings.NewReader(body)),
+					Request:    req,
+				}, nil
+			},
+		),
+	)
+	return client, &requests
+}
@@ -0,0 +1,137 @@
+package gotmdbapi
+
+import (
+	"fmt"
+	"strings"
+)
+
+type (
+	// MovieID is the TMDB ID of a movie
+	MovieID int32
+
+	// PersonID is the TMDB ID of a person
+	PersonID int32
+
+	// GenreID is the TMDB ID of a genre
+	GenreID int32
+
+	// CompanyID is the TMDB ID of a company
+	CompanyID int32
+
+	// KeywordID is the TMDB ID of a keyword
+	KeywordID int32
+
+	// WatchProviderID is the TMDB ID of a watch provider
+	WatchProviderID int32
+
+	// comparableID is the constraint of the typed IDs that can be compared and formatted
+	comparableID interface {
+		comparable
+		fmt.Stringer
+	}
+
+	// ImagePath is the path of a TMDB image, such as a poster, backdrop, profile or logo
+	ImagePath string
+)
+
+// String returns the movie ID as a string
+//
+// Returns:
+//
+// - string: the movie ID
+func (m MovieID) String() string {
+	return fmt.Sprintf("%d", m)
+}
+
+// String returns the person ID as a string
+//
+// Returns:
+//
+// - string: the person ID
+func (p PersonID) String() string {
+	return fmt.Sprintf("%d", p)
+}
+
+// String returns the genre ID as a string
+//
+// Returns:
+//
+// - string: the genre ID
+func (g GenreID) String() string {
+	return fmt.Sprintf("%d", g)
+}
+
+// String returns the company ID as a string
+//
+// Returns:
+//
+// - string: the company ID
+func (c CompanyID) String() string {
+	return fmt.Sprintf("%d", c)
+}
+
+// String returns the keyword ID as a string
+//
+// Returns:
+//
+// - string: the keyword ID
+func (k KeywordID) String() string {
+	return fmt.Sprintf("%d", k)
+}
+
+// String returns the watch provider ID as a string
+//
+// Returns:
+//
+// - string: the watch provider ID
+func (w WatchProviderID) String() string {
+	return fmt.Sprintf("%d", w)
+}
+
+// joinIDs joins the given IDs with the given separator
+//
+// Parameters:
+//
+// - ids: the IDs to join
+// - separator: the separator between the IDs
+//
+// Returns:
+//
+// - string: the joined IDs
+func joinIDs[T fmt.Stringer](ids []T, separator string) string {
+	strIDs := make([]string, len(ids))
+	for i, id := range ids {
+		strIDs[i] = id.String()
+	}
+	return strings.Join(strIDs, separator)
+}
+
+// URL returns the URL of the image with the given size
+//
+// Parameters:
+//
+// - size: the size of the image, such as ImageSizeW500 or ImageSizeH632, or an empty size for the original quality
+//
+// Returns:
+//
+// - string: the image URL, or an empty string if the image path is empty
+func (i ImagePath) URL(size ImageSizeEnum) string {
+	if i == "" {
+		return ""
+	}
+	if size == "" {
+		size = ImageSizeOriginal
+	}
+
+	// Remove the leading slash, since it is already part of the URL format
+	return fmt.Sprintf(ImageSizedURL, size, strings.TrimPrefix(string(i), "/"))
+}
+
+// OriginalURL returns the URL of the image in its original quality
+//
+// Returns:
+//
+// - string: the image URL, or an empty string if the image path is empty
+func (i ImagePath) OriginalURL() string {
+	return i.URL(ImageSizeOriginal)
+}
@@ -0,0 +1,38 @@
+package gotmdbapi
+
+import (
+	"testing"
+)
+
+// TestImagePathURL tests the building of TMDB image URLs
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestImagePathURL(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		path ImagePath
+		size ImageSizeEnum
+		want string
+	}{
+		{name: "default", path: "/poster.jpg", want: "https://image.tmdb.org/t/p/original/poster.jpg"},
+		{
+			name: "original",
+			path: "/poster.jpg",
+			size: ImageSizeOriginal,
+			want: "https://image.tmdb.org/t/p/original/poster.jpg",
+		},
+		{name: "width", path: "/poster.jpg", size: ImageSizeW500, want: "https://image.tmdb.org/t/p/w500/poster.jpg"},
+		{name: "height", path: "/profile.jpg", size: ImageSizeH632, want: "https://image.tmdb.org/t/p/h632/profile.jpg"},
+		{name: "empty path", path: "", size: ImageSizeW500, want: ""},
+	} {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				if got := tc.path.URL(tc.size); got != tc.want {
+					t.Fatalf("URL returned %q, expected %q", got, tc.want)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,143 @@
+package gotmdbapi
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// RedactedValue replaces the credentials in the logged query parameters
+	RedactedValue = "[REDACTED]"
+
+	// DefaultMaxBodySnippet is the default maximum number of bytes of the response bodies logged on errors
+	DefaultMaxBodySnippet = 512
+)
+
+type (
+	// LoggerOptions represents the options of the request logging
+	LoggerOptions struct {
+		// RequestLevel is the level of the successful requests
+		RequestLevel slog.Level
+
+		// ErrorLevel is the level of the failed requests
+		ErrorLevel slog.Level
+
+		// MaxBodySnippet is the maximum number of bytes of the response bodies logged at debug level on errors, where
+		// a non-positive value disables the body snippets
+		MaxBodySnippet int
+	}
+)
+
+var (
+	// redactedQueryParameters are the query parameters holding credentials, which are never logged
+	redactedQueryParameters = []string{APIKey, SessionID, GuestSessionID}
+)
+
+// DefaultLoggerOptions returns the default options of the request logging, which log the successful requests at info
+// level and the failed ones at error level
+//
+// Returns:
+//
+// - LoggerOptions: the default options
+func DefaultLoggerOptions() LoggerOptions {
+	return LoggerOptions{
+		RequestLevel:   slog.LevelInfo,
+		ErrorLevel:     slog.LevelError,
+		MaxBodySnippet: DefaultMaxBodySnippet,
+	}
+}
+
+// WithLogger creates a derived client that logs each request with the given logger
+//
+// Parameters:
+//
+// - logger: the logger, or nil to disable the logging
+// - opts: the logging options (optional, defaults to DefaultLoggerOptions)
+//
+// Returns:
+//
+// - *Client: the derived client
+func (c Client) WithLogger(logger *slog.Logger, opts *LoggerOptions) *Client {
+	c.logger = logger
+	c.loggerOptions = DefaultLoggerOptions()
+	if opts != nil {
+		c.loggerOptions = *opts
+	}
+	return &c
+}
+
+// RedactQuery encodes the query parameters replacing the credentials, such as the API key and session IDs, with
+// RedactedValue
+//
+// Parameters:
+//
+// - query: the query parameters
+//
+// Returns:
+//
+// - string: the encoded and redacted query parameters
+func RedactQuery(query url.Values) string {
+	redacted := url.Values{}
+	for key, values := range query {
+		redacted[key] = values
+	}
+	for _, key := range redactedQueryParameters {
+		if redacted.Has(key) {
+			redacted.Set(key, RedactedValue)
+		}
+	}
+	return redacted.Encode()
+}
+
+// logRequest logs a request to the TMDB API, if the client has a logger
+//
+// Parameters:
+//
+// - req: the HTTP request
+// - meta: the response metadata
+// - err: the error of the request (optional), logged unless it is a non-2xx response, whose body is only logged as
+// a truncated snippet at debug level
+// - body: the response body, logged at debug level on errors (optional)
+func (c Client) logRequest(req *http.Request, meta *ResponseMeta, err error, body []byte) {
+	if c.logger == nil {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("endpoint", meta.Endpoint),
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.String("query", RedactQuery(req.URL.Query())),
+		slog.Int("status", meta.StatusCode),
+		slog.Duration("duration", meta.Latency),
+		slog.Int("retry_count", max(meta.Attempts-1, 0)),
+		slog.Bool("cache_hit", meta.CacheHit),
+	}
+	if err == nil {
+		c.logger.LogAttrs(req.Context(), c.loggerOptions.RequestLevel, "TMDB API request", attrs...)
+		return
+	}
+
+	// Log the TMDB API status code instead of the error of the non-2xx responses, since it embeds the response body
+	if meta.TMDBStatusCode != 0 {
+		attrs = append(attrs, slog.Int("tmdb_status_code", int(meta.TMDBStatusCode)))
+	}
+	if meta.StatusCode == 0 || (meta.StatusCode >= http.StatusOK && meta.StatusCode < http.StatusMultipleChoices) {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	c.logger.LogAttrs(req.Context(), c.loggerOptions.ErrorLevel, "TMDB API request failed", attrs...)
+
+	// Log a snippet of the response body to debug the error
+	if len(body) > 0 && c.loggerOptions.MaxBodySnippet > 0 {
+		snippet := body[:min(len(body), c.loggerOptions.MaxBodySnippet)]
+		c.logger.LogAttrs(
+			req.Context(),
+			slog.LevelDebug,
+			"TMDB API response body",
+			slog.String("endpoint", meta.Endpoint),
+			slog.String("body", string(snippet)),
+			slog.Bool("truncated", len(snippet) < len(body)),
+		)
+	}
+}
@@ -0,0 +1,87 @@
+package gotmdbapi
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// TestRedactQuery tests that the credentials are redacted from the query parameters
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestRedactQuery(t *testing.T) {
+	query := url.Values{
+		APIKey:    {"secret"},
+		SessionID: {"session"},
+		Language:  {"en-US"},
+	}
+	expected := "api_key=%5BREDACTED%5D&language=en-US&session_id=%5BREDACTED%5D"
+	if got := RedactQuery(query); got != expected {
+		t.Errorf("RedactQuery() = %q, expected %q", got, expected)
+	}
+	if query.Get(APIKey) != "secret" {
+		t.Error("RedactQuery() modified the query parameters")
+	}
+}
+
+// TestClientLogger tests that the requests are logged with the endpoint, redacted query, status and body snippet
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestClientLogger(t *testing.T) {
+	client, err := NewClient("api-key")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// Stub the TMDB API, answering with an error
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	opts := DefaultLoggerOptions()
+	opts.MaxBodySnippet = 16
+	client = client.WithLogger(logger, &opts).WithHTTPClient(
+		DoerFunc(
+			func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusUnauthorized,
+					Body: io.NopCloser(
+						strings.NewReader(`{"status_code":7,"status_message":"Invalid API key"}`),
+					),
+					Request: req,
+				}, nil
+			},
+		),
+	)
+
+	session := &Session{ID: "secret-session"}
+	if _, _, err = client.GetAccountDetails(context.Background(), 1, session); err == nil {
+		t.Fatal("GetAccountDetails() succeeded, expected an error")
+	}
+
+	logs := buf.String()
+	for _, expected := range []string{
+		`level=ERROR msg="TMDB API request failed" endpoint=GetAccountDetails method=GET`,
+		`query="session_id=%5BREDACTED%5D"`,
+		"status=401",
+		"retry_count=0 cache_hit=false tmdb_status_code=7\n",
+		`level=DEBUG msg="TMDB API response body" endpoint=GetAccountDetails body="{\"status_code\":7" truncated=true`,
+	} {
+		if !strings.Contains(logs, expected) {
+			t.Errorf("logs do not contain %q:\n%s", expected, logs)
+		}
+	}
+	if strings.Contains(logs, "secret-session") {
+		t.Errorf("logs contain the session ID:\n%s", logs)
+	}
+	if strings.Contains(logs, "Invalid API key") {
+		t.Errorf("logs contain the response body beyond the snippet:\n%s", logs)
+	}
+}
@@ -0,0 +1,78 @@
+package gotmdbapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type (
+	// responseMetaContextKey is the context key of the response metadata captured by the caller
+	responseMetaContextKey struct{}
+)
+
+// ContextWithResponseMeta returns a copy of the context that captures the metadata of the TMDB API response of the
+// call it is passed to, such as its headers, rate limit and attempts
+//
+// The metadata is filled once the call returns. A zero StatusCode means no response was received, where a zero
+// Attempts means the request was not sent. The context must not be shared by concurrent calls, since each call
+// overwrites the metadata without synchronization, so a new one should be created per call.
+//
+// Parameters:
+//
+// - ctx: the parent context
+//
+// Returns:
+//
+// - context.Context: the context capturing the response metadata
+// - *ResponseMeta: the response metadata, filled once the call returns
+func ContextWithResponseMeta(ctx context.Context) (context.Context, *ResponseMeta) {
+	meta := &ResponseMeta{}
+	return context.WithValue(ctx, responseMetaContextKey{}, meta), meta
+}
+
+// captureResponseMeta copies the response metadata to the one captured by the request context, if any
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - meta: the response metadata
+func captureResponseMeta(ctx context.Context, meta *ResponseMeta) {
+	if captured, _ := ctx.Value(responseMetaContextKey{}).(*ResponseMeta); captured != nil {
+		*captured = *meta
+	}
+}
+
+// withoutResponseMeta returns a copy of the context that does not capture the response metadata, for the requests made
+// on behalf of another call, such as to fetch the translations of the language fallback chain
+//
+// Parameters:
+//
+// - ctx: the parent context
+//
+// Returns:
+//
+// - context.Context: the context without the response metadata
+func withoutResponseMeta(ctx context.Context) context.Context {
+	if ctx.Value(responseMetaContextKey{}) == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, responseMetaContextKey{}, (*ResponseMeta)(nil))
+}
+
+// setHeader sets the response header and the metadata parsed from it
+//
+// Parameters:
+//
+// - header: the HTTP response header
+func (m *ResponseMeta) setHeader(header http.Header) {
+	m.Header = header
+	m.ETag = header.Get(ETagHeader)
+	if remaining, err := strconv.Atoi(header.Get(RateLimitRemainingHeader)); err == nil {
+		m.RateLimitRemaining = &remaining
+	}
+	if reset, err := strconv.ParseInt(header.Get(RateLimitResetHeader), 10, 64); err == nil {
+		m.RateLimitReset = time.Unix(reset, 0)
+	}
+}
@@ -0,0 +1,154 @@
+package gotmdbapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestContextWithResponseMeta tests that the response metadata of a call is captured, including the rate limit and
+// entity tag headers, and not overwritten by the language fallback requests
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestContextWithResponseMeta(t *testing.T) {
+	client, err := NewClient("api-key")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// Stub the TMDB API, answering the translations with another status code
+	client = client.WithFallbackLanguages("es").WithHTTPClient(
+		DoerFunc(
+			func(req *http.Request) (*http.Response, error) {
+				if strings.HasSuffix(req.URL.Path, "/translations") {
+					return &http.Response{
+						StatusCode: http.StatusNotFound,
+						Body:       io.NopCloser(strings.NewReader(`{}`)),
+						Request:    req,
+					}, nil
+				}
+
+				header := http.Header{}
+				header.Set(RateLimitRemainingHeader, "39")
+				header.Set(RateLimitResetHeader, "1700000000")
+				header.Set(ETagHeader, `W/"abc"`)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     header,
+					Body:       io.NopCloser(strings.NewReader(`{"id":550,"title":"Fight Club"}`)),
+					Request:    req,
+				}, nil
+			},
+		),
+	)
+
+	ctx, meta := ContextWithResponseMeta(context.Background())
+	if _, _, err = client.GetMovieDetails(ctx, 550, ""); err != nil {
+		t.Fatalf("GetMovieDetails() failed: %v", err)
+	}
+
+	if meta.Endpoint != "GetMovieDetails" || meta.StatusCode != http.StatusOK || meta.Attempts != 1 {
+		t.Errorf(
+			"meta has endpoint %q, status code %d and %d attempts, expected GetMovieDetails, 200 and 1",
+			meta.Endpoint,
+			meta.StatusCode,
+			meta.Attempts,
+		)
+	}
+	if meta.RateLimitRemaining == nil || *meta.RateLimitRemaining != 39 {
+		t.Errorf("meta.RateLimitRemaining is %v, expected 39", meta.RateLimitRemaining)
+	}
+	if !meta.RateLimitReset.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("meta.RateLimitReset is %v, expected %v", meta.RateLimitReset, time.Unix(1700000000, 0))
+	}
+	if meta.ETag != `W/"abc"` {
+		t.Errorf("meta.ETag is %q, expected %q", meta.ETag, `W/"abc"`)
+	}
+	if !strings.HasSuffix(meta.URL, "/movie/550") {
+		t.Errorf("meta.URL is %q, expected the movie details URL", meta.URL)
+	}
+}
+
+// TestContextWithResponseMetaNotSent tests that the response metadata is left empty when the request is not sent
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestContextWithResponseMetaNotSent(t *testing.T) {
+	client, err := NewClient("api-key")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx, meta := ContextWithResponseMeta(context.Background())
+	_, statusCode, err := client.GetAccountDetails(ctx, 1, nil)
+	if err == nil {
+		t.Fatal("GetAccountDetails() succeeded, expected an error")
+	}
+	if statusCode != 0 || meta.StatusCode != 0 || meta.Attempts != 0 {
+		t.Errorf(
+			"status code is %d, and meta has status code %d and %d attempts, expected 0, 0 and 0",
+			statusCode,
+			meta.StatusCode,
+			meta.Attempts,
+		)
+	}
+}
+
+// TestResponseMetaCacheHit tests that only the responses with the CacheHeader are cache hits, and not those of the
+// middlewares that short-circuit the requests
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestResponseMetaCacheHit(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   http.Header
+		expected bool
+	}{
+		{name: "short-circuited", expected: false},
+		{name: "cached", header: http.Header{CacheHeader: {"1"}}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client, err := NewClient("api-key")
+				if err != nil {
+					t.Fatalf("Failed to create client: %v", err)
+				}
+
+				// Answer the requests from a middleware, without reaching the HTTP client
+				client.Use(
+					func(Doer) Doer {
+						return DoerFunc(
+							func(req *http.Request) (*http.Response, error) {
+								return &http.Response{
+									StatusCode: http.StatusServiceUnavailable,
+									Header:     tt.header,
+									Body:       io.NopCloser(strings.NewReader(`{}`)),
+									Request:    req,
+								}, nil
+							},
+						)
+					},
+				)
+
+				ctx, meta := ContextWithResponseMeta(context.Background())
+				_, statusCode, _ := client.GetMovieDetails(ctx, 550, "")
+				if statusCode != http.StatusServiceUnavailable || meta.Attempts != 0 {
+					t.Errorf("status code is %d with %d attempts, expected 503 and 0", statusCode, meta.Attempts)
+				}
+				if meta.CacheHit != tt.expected {
+					t.Errorf("meta.CacheHit is %v, expected %v", meta.CacheHit, tt.expected)
+				}
+			},
+		)
+	}
+}
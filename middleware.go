@@ -0,0 +1,190 @@
+package gotmdbapi
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// Doer sends HTTP requests, such as *http.Client
+	Doer interface {
+		Do(req *http.Request) (*http.Response, error)
+	}
+
+	// DoerFunc is an adapter to use ordinary functions as a Doer
+	DoerFunc func(req *http.Request) (*http.Response, error)
+
+	// Middleware wraps the Doer used to send the TMDB API requests, such as to inject headers or capture bodies
+	Middleware func(next Doer) Doer
+
+	// BeforeRequestHook is called before each TMDB API request is sent, and returns the context the request is sent
+	// with, such as one carrying a tracing span, or nil to keep the request context. The hooks must not modify the
+	// request
+	BeforeRequestHook func(req *http.Request) context.Context
+
+	// AfterResponseHook is called after each TMDB API response is parsed, or the request failed
+	AfterResponseHook func(req *http.Request, meta *ResponseMeta, err error)
+
+	// ResponseMeta represents the metadata of a TMDB API response
+	ResponseMeta struct {
+		// Endpoint is the client method that made the request, such as GetMovieDetails
+		Endpoint string
+
+		// StatusCode is the HTTP status code of the response, or 0 if no response was received
+		StatusCode int
+
+		// Header is the HTTP response header
+		Header http.Header
+
+		// RateLimitRemaining is the number of requests remaining in the rate limit window, or nil if the response did
+		// not report it
+		RateLimitRemaining *int
+
+		// RateLimitReset is the time the rate limit window resets, or the zero time if the response did not report it
+		RateLimitReset time.Time
+
+		// ETag is the entity tag of the response
+		ETag string
+
+		// CacheHit is whether the response was served from a cache instead of the TMDB API, as reported by the
+		// CacheHeader set by the caching middlewares
+		CacheHit bool
+
+		// Attempts is the number of requests sent to the TMDB API, including the retries
+		Attempts int
+
+		// Latency is the duration of the request, including the retries and the response parsing
+		Latency time.Duration
+
+		// URL is the final URL of the request, after the redirects, with the credentials redacted
+		URL string
+
+		// TMDBStatusCode is the TMDB API status code of the unsuccessful responses, such as 7 for an invalid API key
+		TMDBStatusCode int32
+	}
+
+	// endpointContextKey is the context key of the endpoint name
+	endpointContextKey struct{}
+)
+
+const (
+	// CacheHeader is the response header set by the caching middlewares to report a cache hit
+	CacheHeader = "X-From-Cache"
+
+	// RateLimitRemainingHeader is the response header with the number of requests remaining in the rate limit window
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+
+	// RateLimitResetHeader is the response header with the Unix time the rate limit window resets
+	RateLimitResetHeader = "X-RateLimit-Reset"
+
+	// ETagHeader is the response header with the entity tag
+	ETagHeader = "ETag"
+)
+
+var (
+	// defaultHTTPClient is the HTTP client used when the client has no Doer
+	defaultHTTPClient = &http.Client{}
+)
+
+// Do calls f(req)
+//
+// Parameters:
+//
+// - req: the HTTP request
+//
+// Returns:
+//
+// - *http.Response: the HTTP response
+// - error: if there was an error sending the request
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// WithHTTPClient creates a derived client that sends the requests with the given Doer, such as a custom *http.Client
+//
+// Parameters:
+//
+// - doer: the Doer
+//
+// Returns:
+//
+// - *Client: the derived client
+func (c Client) WithHTTPClient(doer Doer) *Client {
+	c.httpClient = doer
+	return &c
+}
+
+// Use adds middlewares to the client, where the first middleware added is the outermost one
+//
+// Parameters:
+//
+// - middlewares: the middlewares
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(slices.Clip(c.middlewares), middlewares...)
+}
+
+// OnBeforeRequest adds hooks called before each request is sent, in the order they were added, where each hook
+// receives the request with the context returned by the previous ones
+//
+// Parameters:
+//
+// - hooks: the hooks
+func (c *Client) OnBeforeRequest(hooks ...BeforeRequestHook) {
+	c.beforeRequestHooks = append(slices.Clip(c.beforeRequestHooks), hooks...)
+}
+
+// OnAfterResponse adds hooks called after each response is parsed or the request failed, in the order they were added
+//
+// Parameters:
+//
+// - hooks: the hooks
+func (c *Client) OnAfterResponse(hooks ...AfterResponseHook) {
+	c.afterResponseHooks = append(slices.Clip(c.afterResponseHooks), hooks...)
+}
+
+// doer returns the Doer used to send the requests, wrapped by the middlewares
+//
+// Parameters:
+//
+// - attempts: the counter incremented each time a request reaches the HTTP client, so the retries of the middlewares
+// are counted, even when they are sent concurrently
+//
+// Returns:
+//
+// - Doer: the wrapped Doer
+func (c Client) doer(attempts *atomic.Int32) Doer {
+	var base Doer = defaultHTTPClient
+	if c.httpClient != nil {
+		base = c.httpClient
+	}
+
+	var doer Doer = DoerFunc(
+		func(req *http.Request) (*http.Response, error) {
+			attempts.Add(1)
+			return base.Do(req)
+		},
+	)
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		doer = c.middlewares[i](doer)
+	}
+	return doer
+}
+
+// EndpointFromContext returns the name of the client method that created the request, such as GetMovieDetails, where
+// the methods with options are named without the WithOptions suffix, as their deprecated counterparts, and the TMDB
+// API v4 methods are prefixed with V4, such as V4GetList
+//
+// Parameters:
+//
+// - ctx: the context of the request
+//
+// Returns:
+//
+// - string: the endpoint name, or an empty string if the request was not created by the client
+func EndpointFromContext(ctx context.Context) string {
+	endpoint, _ := ctx.Value(endpointContextKey{}).(string)
+	return endpoint
+}
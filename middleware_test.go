@@ -0,0 +1,172 @@
+package gotmdbapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestClientMiddlewaresAndHooks tests that the middlewares and hooks wrap the requests of the endpoints
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestClientMiddlewaresAndHooks(t *testing.T) {
+	client, err := NewClient("api-key")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// Stub the TMDB API, checking the headers injected by the middlewares
+	client = client.WithHTTPClient(
+		DoerFunc(
+			func(req *http.Request) (*http.Response, error) {
+				if got := strings.Join(req.Header.Values("X-Trace"), ","); got != "outer,inner" {
+					t.Errorf("X-Trace header is %q, expected %q", got, "outer,inner")
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": {"application/json"}},
+					Body:       io.NopCloser(strings.NewReader(`{"id":550,"title":"Fight Club"}`)),
+					Request:    req,
+				}, nil
+			},
+		),
+	)
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(
+				func(req *http.Request) (*http.Response, error) {
+					calls = append(calls, name)
+					req.Header.Add("X-Trace", name)
+					return next.Do(req)
+				},
+			)
+		}
+	}
+	client.Use(trace("outer"), trace("inner"))
+	client.OnBeforeRequest(
+		func(req *http.Request) context.Context {
+			calls = append(calls, "before "+EndpointFromContext(req.Context()))
+			return nil
+		},
+	)
+
+	var meta *ResponseMeta
+	client.OnAfterResponse(
+		func(req *http.Request, m *ResponseMeta, err error) {
+			if err != nil {
+				t.Errorf("After hook received unexpected error: %v", err)
+			}
+			meta = m
+		},
+	)
+
+	response, _, err := client.GetMovieDetails(context.Background(), 550, "")
+	if err != nil {
+		t.Fatalf("GetMovieDetails returned unexpected error: %v", err)
+	}
+	if response.Title != "Fight Club" {
+		t.Fatalf("GetMovieDetails returned title %q, expected %q", response.Title, "Fight Club")
+	}
+
+	// Check the hooks and middlewares were called in order
+	if got, want := strings.Join(calls, ";"), "before GetMovieDetails;outer;inner"; got != want {
+		t.Fatalf("Calls are %q, expected %q", got, want)
+	}
+	if meta == nil || meta.Endpoint != "GetMovieDetails" || meta.StatusCode != http.StatusOK {
+		t.Fatalf("After hook received metadata %+v", meta)
+	}
+}
+
+// TestEndpointNames tests that the deprecated methods share the endpoint name of their counterparts with options, and
+// the TMDB API v4 methods are prefixed with V4
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestEndpointNames(t *testing.T) {
+	client, err := NewClient("api-key")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// Stub the TMDB API, recording the endpoint names
+	var endpoints []string
+	client = client.WithHTTPClient(
+		DoerFunc(
+			func(req *http.Request) (*http.Response, error) {
+				endpoints = append(endpoints, EndpointFromContext(req.Context()))
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{}`)),
+					Request:    req,
+				}, nil
+			},
+		),
+	)
+
+	ctx := context.Background()
+	// nolint:staticcheck
+	_, _, _ = client.GetMoviesPopular(ctx, "", 0, "")
+	_, _, _ = client.GetMoviesPopularWithOptions(ctx, nil)
+	// nolint:staticcheck
+	_, _, _ = client.SearchMovies(ctx, "fight", false, "", 0, 0, "", 0)
+	_, _, _ = client.V4().GetList(ctx, "", 1, "", 0, "")
+
+	want := "GetMoviesPopular,GetMoviesPopular,SearchMovies,V4GetList"
+	if got := strings.Join(endpoints, ","); got != want {
+		t.Errorf("endpoints are %q, expected %q", got, want)
+	}
+}
+
+// TestConcurrentAttempts tests that the attempts of a middleware that sends the request concurrently are all counted
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestConcurrentAttempts(t *testing.T) {
+	client, _ := newStubbedClient(t, "api-key", http.StatusOK, `{"id":550}`)
+
+	// Hedge the requests, sending them concurrently and keeping the last response
+	client.Use(
+		func(next Doer) Doer {
+			return DoerFunc(
+				func(req *http.Request) (*http.Response, error) {
+					var wg sync.WaitGroup
+					var mu sync.Mutex
+					var resp *http.Response
+					var err error
+					for range 3 {
+						wg.Go(
+							func() {
+								r, e := next.Do(req.Clone(req.Context()))
+								mu.Lock()
+								defer mu.Unlock()
+								if resp != nil {
+									resp.Body.Close()
+								}
+								resp, err = r, e
+							},
+						)
+					}
+					wg.Wait()
+					return resp, err
+				},
+			)
+		},
+	)
+
+	ctx, meta := ContextWithResponseMeta(context.Background())
+	if _, _, err := client.GetMovieDetails(ctx, 550, ""); err != nil {
+		t.Fatalf("GetMovieDetails() failed: %v", err)
+	}
+	if meta.Attempts != 3 {
+		t.Errorf("meta has %d attempts, expected 3", meta.Attempts)
+	}
+}
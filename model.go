@@ -1,55 +1,60 @@
 package gotmdbapi
 
+import (
+	"encoding/json"
+	"time"
+)
+
 type (
 	// DateRange represents a date range with maximum and minimum dates
 	DateRange struct {
-		Maximum string `json:"maximum"`
-		Minimum string `json:"minimum"`
+		Maximum Date `json:"maximum"`
+		Minimum Date `json:"minimum"`
 	}
 
 	// Crew represents a crew member in a movie
 	Crew struct {
-		Adult              bool     `json:"adult"`
-		Gender             *int32   `json:"gender,omitempty"`
-		ID                 int32    `json:"id"`
-		KnownForDepartment string   `json:"known_for_department"`
-		Name               string   `json:"name"`
-		OriginalName       string   `json:"original_name"`
-		Popularity         *float32 `json:"popularity,omitempty"`
-		ProfilePath        *string  `json:"profile_path,omitempty"`
-		CreditID           string   `json:"credit_id"`
-		Department         string   `json:"department"`
-		Job                *string  `json:"job,omitempty"`
+		Adult              bool           `json:"adult"`
+		Gender             GenderEnum     `json:"gender,omitempty"`
+		ID                 PersonID       `json:"id"`
+		KnownForDepartment DepartmentEnum `json:"known_for_department"`
+		Name               string         `json:"name"`
+		OriginalName       string         `json:"original_name"`
+		Popularity         *float32       `json:"popularity,omitempty"`
+		ProfilePath        ImagePath      `json:"profile_path,omitempty"`
+		CreditID           string         `json:"credit_id"`
+		Department         DepartmentEnum `json:"department"`
+		Job                *string        `json:"job,omitempty"`
 	}
 
 	// Cast represents a cast member in a movie
 	Cast struct {
-		Adult              bool     `json:"adult"`
-		Gender             *int32   `json:"gender,omitempty"`
-		ID                 int32    `json:"id"`
-		KnownForDepartment string   `json:"known_for_department"`
-		Name               string   `json:"name"`
-		OriginalName       string   `json:"original_name"`
-		Popularity         *float32 `json:"popularity,omitempty"`
-		ProfilePath        *string  `json:"profile_path,omitempty"`
-		CreditID           string   `json:"credit_id"`
-		CastID             int32    `json:"cast_id"`
-		Character          string   `json:"character"`
-		Order              *int32   `json:"order,omitempty"`
+		Adult              bool           `json:"adult"`
+		Gender             GenderEnum     `json:"gender,omitempty"`
+		ID                 PersonID       `json:"id"`
+		KnownForDepartment DepartmentEnum `json:"known_for_department"`
+		Name               string         `json:"name"`
+		OriginalName       string         `json:"original_name"`
+		Popularity         *float32       `json:"popularity,omitempty"`
+		ProfilePath        ImagePath      `json:"profile_path,omitempty"`
+		CreditID           string         `json:"credit_id"`
+		CastID             int32          `json:"cast_id"`
+		Character          string         `json:"character"`
+		Order              *int32         `json:"order,omitempty"`
 	}
 
 	// Genre represents a movie genre
 	Genre struct {
-		ID   int32  `json:"id"`
-		Name string `json:"name"`
+		ID   GenreID `json:"id"`
+		Name string  `json:"name"`
 	}
 
 	// ProductionCompany represents a movie production company
 	ProductionCompany struct {
-		ID            int32   `json:"id"`
-		LogoPath      *string `json:"logo_path,omitempty"`
-		Name          string  `json:"name"`
-		OriginCountry *string `json:"origin_country,omitempty"`
+		ID            CompanyID `json:"id"`
+		LogoPath      ImagePath `json:"logo_path,omitempty"`
+		Name          string    `json:"name"`
+		OriginCountry *string   `json:"origin_country,omitempty"`
 	}
 
 	// ProductionCountry represents a movie production country
@@ -69,20 +74,21 @@ type (
 
 	// SimpleMovie represents a simplified movie structure
 	SimpleMovie struct {
-		Adult            bool     `json:"adult"`
-		BackdropPath     string   `json:"backdrop_path"`
-		GenreIDs         []int32  `json:"genre_ids"`
-		ID               int32    `json:"id"`
-		OriginalLanguage string   `json:"original_language"`
-		OriginalTitle    string   `json:"original_title"`
-		Overview         string   `json:"overview"`
-		Popularity       *float32 `json:"popularity,omitempty"`
-		PosterPath       string   `json:"poster_path"`
-		ReleaseDate      string   `json:"release_date"`
-		Title            string   `json:"title"`
-		Video            bool     `json:"video"`
-		VoteAverage      *float32 `json:"vote_average,omitempty"`
-		VoteCount        *int32   `json:"vote_count,omitempty"`
+		Adult            bool           `json:"adult"`
+		BackdropPath     ImagePath      `json:"backdrop_path"`
+		GenreIDs         []GenreID      `json:"genre_ids"`
+		ID               MovieID        `json:"id"`
+		OriginalLanguage string         `json:"original_language"`
+		OriginalTitle    string         `json:"original_title"`
+		Overview         string         `json:"overview"`
+		Popularity       *float32       `json:"popularity,omitempty"`
+		PosterPath       ImagePath      `json:"poster_path"`
+		ReleaseDate      Date           `json:"release_date"`
+		Title            string         `json:"title"`
+		Video            bool           `json:"video"`
+		VoteAverage      *float32       `json:"vote_average,omitempty"`
+		VoteCount        *int32         `json:"vote_count,omitempty"`
+		FieldLanguages   FieldLanguages `json:"-"`
 	}
 
 	// DateMovieListResponse represents a movie list response with date range
@@ -104,46 +110,47 @@ type (
 
 	// MovieCreditsResponse represents a movie credits response
 	MovieCreditsResponse struct {
-		ID   int32  `json:"id"`
-		Cast []Cast `json:"cast"`
-		Crew []Crew `json:"crew"`
+		ID   MovieID `json:"id"`
+		Cast []Cast  `json:"cast"`
+		Crew []Crew  `json:"crew"`
 	}
 
 	// MovieDetailsResponse represents a movie details response
 	MovieDetailsResponse struct {
 		Adult               bool                `json:"adult"`
-		BackdropPath        string              `json:"backdrop_path"`
+		BackdropPath        ImagePath           `json:"backdrop_path"`
 		BelongsToCollection *string             `json:"belongs_to_collection,omitempty"`
 		Budget              *int64              `json:"budget,omitempty"`
 		Genres              []Genre             `json:"genres"`
 		Homepage            *string             `json:"homepage,omitempty"`
-		ID                  int32               `json:"id"`
+		ID                  MovieID             `json:"id"`
 		ImdbID              string              `json:"imdb_id"`
 		OriginalLanguage    string              `json:"original_language"`
 		OriginalTitle       string              `json:"original_title"`
 		Overview            string              `json:"overview"`
 		Popularity          *float32            `json:"popularity,omitempty"`
-		PosterPath          string              `json:"poster_path"`
+		PosterPath          ImagePath           `json:"poster_path"`
 		ProductionCompanies []ProductionCompany `json:"production_companies"`
 		ProductionCountries []ProductionCountry `json:"production_countries"`
-		ReleaseDate         string              `json:"release_date"`
+		ReleaseDate         Date                `json:"release_date"`
 		Revenue             *int64              `json:"revenue,omitempty"`
 		Runtime             *int32              `json:"runtime,omitempty"`
 		SpokenLanguages     []SpokenLanguage    `json:"spoken_languages"`
-		Status              string              `json:"status"`
+		Status              MovieStatusEnum     `json:"status"`
 		Tagline             *string             `json:"tagline,omitempty"`
 		Title               string              `json:"title"`
 		Video               *bool               `json:"video,omitempty"`
 		VoteAverage         *float32            `json:"vote_average,omitempty"`
 		VoteCount           *int32              `json:"vote_count,omitempty"`
+		FieldLanguages      FieldLanguages      `json:"-"`
 	}
 
 	// AuthorDetails represents the details of an author in a review
 	AuthorDetails struct {
-		Name       string  `json:"name"`
-		Username   string  `json:"username"`
-		AvatarPath *string `json:"avatar_path,omitempty"`
-		Rating     *int32  `json:"rating,omitempty"`
+		Name       string    `json:"name"`
+		Username   string    `json:"username"`
+		AvatarPath ImagePath `json:"avatar_path,omitempty"`
+		Rating     *int32    `json:"rating,omitempty"`
 	}
 
 	// Review represents a movie review
@@ -151,23 +158,971 @@ type (
 		Author        string        `json:"author"`
 		AuthorDetails AuthorDetails `json:"author_details"`
 		Content       string        `json:"content"`
-		CreatedAt     string        `json:"created_at"`
+		CreatedAt     time.Time     `json:"created_at"`
 		ID            string        `json:"id"`
-		UpdatedAt     string        `json:"updated_at"`
+		UpdatedAt     time.Time     `json:"updated_at"`
 		URL           string        `json:"url"`
 	}
 
 	// MovieReviewsResponse represents a movie reviews response
 	MovieReviewsResponse struct {
-		ID           int32    `json:"id"`
+		ID           MovieID  `json:"id"`
 		Page         int32    `json:"page"`
 		Results      []Review `json:"results"`
 		TotalPages   int32    `json:"total_pages"`
 		TotalResults int32    `json:"total_results"`
 	}
 
+	// ReviewDetailsResponse represents a review details response
+	ReviewDetailsResponse struct {
+		Review
+		// nolint:revive
+		ISO639_1   string        `json:"iso_639_1"`
+		MediaID    int32         `json:"media_id"`
+		MediaTitle string        `json:"media_title"`
+		MediaType  MediaTypeEnum `json:"media_type"`
+	}
+
+	// CreditEpisode represents a TV episode of a credit
+	CreditEpisode struct {
+		AirDate        Date      `json:"air_date"`
+		EpisodeNumber  int32     `json:"episode_number"`
+		ID             int32     `json:"id"`
+		Name           string    `json:"name"`
+		Overview       string    `json:"overview"`
+		ProductionCode string    `json:"production_code"`
+		Runtime        *int32    `json:"runtime,omitempty"`
+		SeasonNumber   int32     `json:"season_number"`
+		ShowID         int32     `json:"show_id"`
+		StillPath      ImagePath `json:"still_path,omitempty"`
+		VoteAverage    *float32  `json:"vote_average,omitempty"`
+		VoteCount      *int32    `json:"vote_count,omitempty"`
+	}
+
+	// CreditSeason represents a TV season of a credit
+	CreditSeason struct {
+		AirDate      Date      `json:"air_date"`
+		EpisodeCount int32     `json:"episode_count"`
+		ID           int32     `json:"id"`
+		Name         string    `json:"name"`
+		Overview     string    `json:"overview"`
+		PosterPath   ImagePath `json:"poster_path,omitempty"`
+		SeasonNumber int32     `json:"season_number"`
+		ShowID       int32     `json:"show_id"`
+	}
+
+	// CreditMedia represents the movie or TV show of a credit
+	CreditMedia struct {
+		Adult            bool            `json:"adult"`
+		BackdropPath     ImagePath       `json:"backdrop_path,omitempty"`
+		Character        string          `json:"character"`
+		Episodes         []CreditEpisode `json:"episodes,omitempty"`
+		FirstAirDate     Date            `json:"first_air_date"`
+		GenreIDs         []GenreID       `json:"genre_ids"`
+		ID               int32           `json:"id"`
+		MediaType        MediaTypeEnum   `json:"media_type"`
+		Name             *string         `json:"name,omitempty"`
+		OriginCountry    []string        `json:"origin_country,omitempty"`
+		OriginalLanguage string          `json:"original_language"`
+		OriginalName     *string         `json:"original_name,omitempty"`
+		OriginalTitle    *string         `json:"original_title,omitempty"`
+		Overview         string          `json:"overview"`
+		Popularity       *float32        `json:"popularity,omitempty"`
+		PosterPath       ImagePath       `json:"poster_path,omitempty"`
+		ReleaseDate      Date            `json:"release_date"`
+		Seasons          []CreditSeason  `json:"seasons,omitempty"`
+		Title            *string         `json:"title,omitempty"`
+		Video            *bool           `json:"video,omitempty"`
+		VoteAverage      *float32        `json:"vote_average,omitempty"`
+		VoteCount        *int32          `json:"vote_count,omitempty"`
+	}
+
+	// CreditPerson represents the person of a credit
+	CreditPerson struct {
+		Adult              bool           `json:"adult"`
+		Gender             GenderEnum     `json:"gender,omitempty"`
+		ID                 PersonID       `json:"id"`
+		KnownForDepartment DepartmentEnum `json:"known_for_department"`
+		MediaType          string         `json:"media_type"`
+		Name               string         `json:"name"`
+		OriginalName       string         `json:"original_name"`
+		Popularity         *float32       `json:"popularity,omitempty"`
+		ProfilePath        ImagePath      `json:"profile_path,omitempty"`
+	}
+
+	// CreditDetailsResponse represents a credit details response
+	CreditDetailsResponse struct {
+		CreditType string         `json:"credit_type"`
+		Department DepartmentEnum `json:"department"`
+		ID         string         `json:"id"`
+		Job        string         `json:"job"`
+		Media      CreditMedia    `json:"media"`
+		MediaType  MediaTypeEnum  `json:"media_type"`
+		Person     CreditPerson   `json:"person"`
+	}
+
+	// Network represents a TV network
+	Network struct {
+		ID            int32     `json:"id"`
+		LogoPath      ImagePath `json:"logo_path,omitempty"`
+		Name          string    `json:"name"`
+		OriginCountry string    `json:"origin_country"`
+	}
+
+	// EpisodeGroup represents a TV episode group
+	EpisodeGroup struct {
+		Description  string               `json:"description"`
+		EpisodeCount int32                `json:"episode_count"`
+		GroupCount   int32                `json:"group_count"`
+		ID           string               `json:"id"`
+		Name         string               `json:"name"`
+		Network      *Network             `json:"network,omitempty"`
+		Type         EpisodeGroupTypeEnum `json:"type"`
+	}
+
+	// TVEpisodeGroupsResponse represents a TV episode groups response
+	TVEpisodeGroupsResponse struct {
+		ID      int32          `json:"id"`
+		Results []EpisodeGroup `json:"results"`
+	}
+
+	// EpisodeGroupEpisode represents a TV episode within an episode group, where Order is its position in the group
+	EpisodeGroupEpisode struct {
+		CreditEpisode
+		Order int32 `json:"order"`
+	}
+
+	// EpisodeGroupGroup represents a group of episodes within an episode group, such as an alternative season
+	EpisodeGroupGroup struct {
+		Episodes []EpisodeGroupEpisode `json:"episodes"`
+		ID       string                `json:"id"`
+		Locked   bool                  `json:"locked"`
+		Name     string                `json:"name"`
+		Order    int32                 `json:"order"`
+	}
+
+	// TVEpisodeGroupDetailsResponse represents a TV episode group details response
+	TVEpisodeGroupDetailsResponse struct {
+		EpisodeGroup
+		Groups []EpisodeGroupGroup `json:"groups"`
+	}
+
+	// AggregateCastRole represents a role played by a cast member in a TV season
+	AggregateCastRole struct {
+		CreditID     string `json:"credit_id"`
+		Character    string `json:"character"`
+		EpisodeCount int32  `json:"episode_count"`
+	}
+
+	// AggregateCast represents a cast member of a TV season, aggregated across its episodes
+	AggregateCast struct {
+		Adult              bool                `json:"adult"`
+		Gender             GenderEnum          `json:"gender,omitempty"`
+		ID                 PersonID            `json:"id"`
+		KnownForDepartment DepartmentEnum      `json:"known_for_department"`
+		Name               string              `json:"name"`
+		Order              *int32              `json:"order,omitempty"`
+		OriginalName       string              `json:"original_name"`
+		Popularity         *float32            `json:"popularity,omitempty"`
+		ProfilePath        ImagePath           `json:"profile_path,omitempty"`
+		Roles              []AggregateCastRole `json:"roles"`
+		TotalEpisodeCount  int32               `json:"total_episode_count"`
+	}
+
+	// AggregateCrewJob represents a job done by a crew member in a TV season
+	AggregateCrewJob struct {
+		CreditID     string `json:"credit_id"`
+		Job          string `json:"job"`
+		EpisodeCount int32  `json:"episode_count"`
+	}
+
+	// AggregateCrew represents a crew member of a TV season, aggregated across its episodes
+	AggregateCrew struct {
+		Adult              bool               `json:"adult"`
+		Department         DepartmentEnum     `json:"department"`
+		Gender             GenderEnum         `json:"gender,omitempty"`
+		ID                 PersonID           `json:"id"`
+		Jobs               []AggregateCrewJob `json:"jobs"`
+		KnownForDepartment DepartmentEnum     `json:"known_for_department"`
+		Name               string             `json:"name"`
+		OriginalName       string             `json:"original_name"`
+		Popularity         *float32           `json:"popularity,omitempty"`
+		ProfilePath        ImagePath          `json:"profile_path,omitempty"`
+		TotalEpisodeCount  int32              `json:"total_episode_count"`
+	}
+
+	// AggregateCreditsResponse represents an aggregate credits response
+	AggregateCreditsResponse struct {
+		Cast []AggregateCast `json:"cast"`
+		Crew []AggregateCrew `json:"crew"`
+		ID   int32           `json:"id"`
+	}
+
+	// PosterImagesResponse represents a poster images response
+	PosterImagesResponse struct {
+		ID      int32   `json:"id"`
+		Posters []Image `json:"posters"`
+	}
+
+	// Video represents a video of a TMDB resource
+	Video struct {
+		ID string `json:"id"`
+		// nolint:revive
+		ISO639_1 string `json:"iso_639_1"`
+		// nolint:revive
+		ISO3166_1   string        `json:"iso_3166_1"`
+		Key         string        `json:"key"`
+		Name        string        `json:"name"`
+		Official    bool          `json:"official"`
+		PublishedAt time.Time     `json:"published_at"`
+		Site        VideoSiteEnum `json:"site"`
+		Size        int32         `json:"size"`
+		Type        VideoTypeEnum `json:"type"`
+	}
+
+	// VideosResponse represents a videos response
+	VideosResponse struct {
+		ID      int32   `json:"id"`
+		Results []Video `json:"results"`
+	}
+
+	// WatchProvider represents a watch provider
+	WatchProvider struct {
+		DisplayPriority int32           `json:"display_priority"`
+		LogoPath        ImagePath       `json:"logo_path,omitempty"`
+		ProviderID      WatchProviderID `json:"provider_id"`
+		ProviderName    string          `json:"provider_name"`
+	}
+
+	// CountryWatchProviders represents the watch providers available in a country
+	CountryWatchProviders struct {
+		Ads      []WatchProvider `json:"ads,omitempty"`
+		Buy      []WatchProvider `json:"buy,omitempty"`
+		Flatrate []WatchProvider `json:"flatrate,omitempty"`
+		Free     []WatchProvider `json:"free,omitempty"`
+		Link     string          `json:"link"`
+		Rent     []WatchProvider `json:"rent,omitempty"`
+	}
+
+	// WatchProvidersResponse represents a watch providers response, where the results are keyed by country code
+	WatchProvidersResponse struct {
+		ID      int32                            `json:"id"`
+		Results map[string]CountryWatchProviders `json:"results"`
+	}
+
+	// TVSeasonExternalIDsResponse represents a TV season external IDs response
+	TVSeasonExternalIDsResponse struct {
+		FreebaseID  *string `json:"freebase_id,omitempty"`
+		FreebaseMID *string `json:"freebase_mid,omitempty"`
+		ID          int32   `json:"id"`
+		TVDBID      *int32  `json:"tvdb_id,omitempty"`
+		TVRageID    *int32  `json:"tvrage_id,omitempty"`
+		WikidataID  *string `json:"wikidata_id,omitempty"`
+	}
+
 	// GenreListResponse represents a genre list response
 	GenreListResponse struct {
 		Genres []Genre `json:"genres"`
 	}
+
+	// Image represents an image of a TMDB resource
+	Image struct {
+		AspectRatio float32   `json:"aspect_ratio"`
+		FilePath    ImagePath `json:"file_path"`
+		FileType    *string   `json:"file_type,omitempty"`
+		Height      int32     `json:"height"`
+		ID          *string   `json:"id,omitempty"`
+		// nolint:revive
+		ISO639_1    *string  `json:"iso_639_1,omitempty"`
+		VoteAverage *float32 `json:"vote_average,omitempty"`
+		VoteCount   *int32   `json:"vote_count,omitempty"`
+		Width       int32    `json:"width"`
+	}
+
+	// LogoImagesResponse represents a logo images response
+	LogoImagesResponse struct {
+		ID    int32   `json:"id"`
+		Logos []Image `json:"logos"`
+	}
+
+	// ParentCompany represents the parent company of a production company
+	ParentCompany struct {
+		ID       CompanyID `json:"id"`
+		LogoPath ImagePath `json:"logo_path,omitempty"`
+		Name     string    `json:"name"`
+	}
+
+	// CompanyDetailsResponse represents a company details response
+	CompanyDetailsResponse struct {
+		Description   string         `json:"description"`
+		Headquarters  string         `json:"headquarters"`
+		Homepage      string         `json:"homepage"`
+		ID            CompanyID      `json:"id"`
+		LogoPath      ImagePath      `json:"logo_path,omitempty"`
+		Name          string         `json:"name"`
+		OriginCountry string         `json:"origin_country"`
+		ParentCompany *ParentCompany `json:"parent_company,omitempty"`
+	}
+
+	// AlternativeName represents an alternative name of a company
+	AlternativeName struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	}
+
+	// CompanyAlternativeNamesResponse represents a company alternative names response
+	CompanyAlternativeNamesResponse struct {
+		ID      CompanyID         `json:"id"`
+		Results []AlternativeName `json:"results"`
+	}
+
+	// NetworkDetailsResponse represents a network details response
+	NetworkDetailsResponse struct {
+		Headquarters  string    `json:"headquarters"`
+		Homepage      string    `json:"homepage"`
+		ID            int32     `json:"id"`
+		LogoPath      ImagePath `json:"logo_path,omitempty"`
+		Name          string    `json:"name"`
+		OriginCountry string    `json:"origin_country"`
+	}
+
+	// KeywordDetailsResponse represents a keyword details response
+	KeywordDetailsResponse struct {
+		ID   KeywordID `json:"id"`
+		Name string    `json:"name"`
+	}
+
+	// ChangedItem represents an item that has changed in the TMDB database
+	ChangedItem struct {
+		Adult *bool `json:"adult,omitempty"`
+		ID    int32 `json:"id"`
+	}
+
+	// ChangeListResponse represents the response of the list of changed items
+	ChangeListResponse struct {
+		Page         int32         `json:"page"`
+		Results      []ChangedItem `json:"results"`
+		TotalPages   int32         `json:"total_pages"`
+		TotalResults int32         `json:"total_results"`
+	}
+
+	// ChangeItem represents a single change made to a field of an item
+	ChangeItem struct {
+		Action ChangeActionEnum `json:"action"`
+		ID     string           `json:"id"`
+		// nolint:revive
+		ISO3166_1 *string `json:"iso_3166_1,omitempty"`
+		// nolint:revive
+		ISO639_1      *string         `json:"iso_639_1,omitempty"`
+		OriginalValue json.RawMessage `json:"original_value,omitempty"`
+		Time          string          `json:"time"`
+		Value         json.RawMessage `json:"value,omitempty"`
+	}
+
+	// Change represents the changes made to a field of an item
+	Change struct {
+		Items []ChangeItem `json:"items"`
+		Key   string       `json:"key"`
+	}
+
+	// ChangesResponse represents the response of the changes made to an item
+	ChangesResponse struct {
+		Changes []Change `json:"changes"`
+	}
+
+	// StatusResponse represents a TMDB API status response
+	StatusResponse struct {
+		Success       bool   `json:"success"`
+		StatusCode    int32  `json:"status_code"`
+		StatusMessage string `json:"status_message"`
+	}
+
+	// RequestTokenResponse represents a request token response
+	RequestTokenResponse struct {
+		Success      bool   `json:"success"`
+		ExpiresAt    string `json:"expires_at"`
+		RequestToken string `json:"request_token"`
+	}
+
+	// SessionResponse represents a session response
+	SessionResponse struct {
+		Success   bool   `json:"success"`
+		SessionID string `json:"session_id"`
+	}
+
+	// GuestSessionResponse represents a guest session response
+	GuestSessionResponse struct {
+		Success        bool   `json:"success"`
+		GuestSessionID string `json:"guest_session_id"`
+		ExpiresAt      string `json:"expires_at"`
+	}
+
+	// ValidateRequestTokenWithLoginRequest represents the body of a request token validation with login request
+	ValidateRequestTokenWithLoginRequest struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		RequestToken string `json:"request_token"`
+	}
+
+	// CreateSessionRequest represents the body of a create session request
+	CreateSessionRequest struct {
+		RequestToken string `json:"request_token"`
+	}
+
+	// CreateSessionFromV4TokenRequest represents the body of a create session from v4 access token request
+	CreateSessionFromV4TokenRequest struct {
+		AccessToken string `json:"access_token"`
+	}
+
+	// DeleteSessionRequest represents the body of a delete session request
+	DeleteSessionRequest struct {
+		SessionID string `json:"session_id"`
+	}
+
+	// Gravatar represents the Gravatar avatar of an account
+	Gravatar struct {
+		Hash string `json:"hash"`
+	}
+
+	// TMDBAvatar represents the TMDB avatar of an account
+	TMDBAvatar struct {
+		AvatarPath ImagePath `json:"avatar_path,omitempty"`
+	}
+
+	// Avatar represents the avatars of an account
+	Avatar struct {
+		Gravatar Gravatar   `json:"gravatar"`
+		TMDB     TMDBAvatar `json:"tmdb"`
+	}
+
+	// AccountDetailsResponse represents an account details response
+	AccountDetailsResponse struct {
+		Avatar Avatar `json:"avatar"`
+		ID     int32  `json:"id"`
+		// nolint:revive
+		ISO639_1 string `json:"iso_639_1"`
+		// nolint:revive
+		ISO3166_1    string `json:"iso_3166_1"`
+		Name         string `json:"name"`
+		IncludeAdult bool   `json:"include_adult"`
+		Username     string `json:"username"`
+	}
+
+	// FavoriteRequest represents the body of a mark as favorite request
+	FavoriteRequest struct {
+		MediaType MediaTypeEnum `json:"media_type"`
+		MediaID   int32         `json:"media_id"`
+		Favorite  bool          `json:"favorite"`
+	}
+
+	// WatchlistRequest represents the body of an add to watchlist request
+	WatchlistRequest struct {
+		MediaType MediaTypeEnum `json:"media_type"`
+		MediaID   int32         `json:"media_id"`
+		Watchlist bool          `json:"watchlist"`
+	}
+
+	// SimpleTV represents a simplified TV show structure
+	SimpleTV struct {
+		Adult            bool      `json:"adult"`
+		BackdropPath     ImagePath `json:"backdrop_path"`
+		FirstAirDate     Date      `json:"first_air_date"`
+		GenreIDs         []GenreID `json:"genre_ids"`
+		ID               int32     `json:"id"`
+		Name             string    `json:"name"`
+		OriginCountry    []string  `json:"origin_country"`
+		OriginalLanguage string    `json:"original_language"`
+		OriginalName     string    `json:"original_name"`
+		Overview         string    `json:"overview"`
+		Popularity       *float32  `json:"popularity,omitempty"`
+		PosterPath       ImagePath `json:"poster_path"`
+		VoteAverage      *float32  `json:"vote_average,omitempty"`
+		VoteCount        *int32    `json:"vote_count,omitempty"`
+	}
+
+	// TVListResponse represents a generic TV show list response
+	TVListResponse struct {
+		Page         int32      `json:"page"`
+		Results      []SimpleTV `json:"results"`
+		TotalPages   int32      `json:"total_pages"`
+		TotalResults int32      `json:"total_results"`
+	}
+
+	// RatedMovie represents a movie rated by an account
+	RatedMovie struct {
+		SimpleMovie
+		Rating float32 `json:"rating"`
+	}
+
+	// RatedMovieListResponse represents a rated movie list response
+	RatedMovieListResponse struct {
+		Page         int32        `json:"page"`
+		Results      []RatedMovie `json:"results"`
+		TotalPages   int32        `json:"total_pages"`
+		TotalResults int32        `json:"total_results"`
+	}
+
+	// RatedTV represents a TV show rated by an account
+	RatedTV struct {
+		SimpleTV
+		Rating float32 `json:"rating"`
+	}
+
+	// RatedTVListResponse represents a rated TV show list response
+	RatedTVListResponse struct {
+		Page         int32     `json:"page"`
+		Results      []RatedTV `json:"results"`
+		TotalPages   int32     `json:"total_pages"`
+		TotalResults int32     `json:"total_results"`
+	}
+
+	// RatedTVEpisode represents a TV episode rated by an account
+	RatedTVEpisode struct {
+		AirDate        Date      `json:"air_date"`
+		EpisodeNumber  int32     `json:"episode_number"`
+		ID             int32     `json:"id"`
+		Name           string    `json:"name"`
+		Overview       string    `json:"overview"`
+		ProductionCode string    `json:"production_code"`
+		Rating         float32   `json:"rating"`
+		Runtime        *int32    `json:"runtime,omitempty"`
+		SeasonNumber   int32     `json:"season_number"`
+		ShowID         int32     `json:"show_id"`
+		StillPath      ImagePath `json:"still_path,omitempty"`
+		VoteAverage    *float32  `json:"vote_average,omitempty"`
+		VoteCount      *int32    `json:"vote_count,omitempty"`
+	}
+
+	// RatedTVEpisodeListResponse represents a rated TV episode list response
+	RatedTVEpisodeListResponse struct {
+		Page         int32            `json:"page"`
+		Results      []RatedTVEpisode `json:"results"`
+		TotalPages   int32            `json:"total_pages"`
+		TotalResults int32            `json:"total_results"`
+	}
+
+	// RatingRequest represents the body of a rating request
+	RatingRequest struct {
+		Value float32 `json:"value"`
+	}
+
+	// CreateListRequest represents the body of a create list request
+	CreateListRequest struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Language    string `json:"language,omitempty"`
+	}
+
+	// CreateListResponse represents a create list response
+	CreateListResponse struct {
+		StatusResponse
+		ListID int32 `json:"list_id"`
+	}
+
+	// ListItemRequest represents the body of an add or remove list item request
+	ListItemRequest struct {
+		MediaID MovieID `json:"media_id"`
+	}
+
+	// ListItem represents an item of a list
+	ListItem struct {
+		SimpleMovie
+		MediaType MediaTypeEnum `json:"media_type"`
+	}
+
+	// ListDetailsResponse represents a list details response
+	ListDetailsResponse struct {
+		CreatedBy     string     `json:"created_by"`
+		Description   string     `json:"description"`
+		FavoriteCount int32      `json:"favorite_count"`
+		ID            string     `json:"id"`
+		Items         []ListItem `json:"items"`
+		ItemCount     int32      `json:"item_count"`
+		// nolint:revive
+		ISO639_1     string    `json:"iso_639_1"`
+		Name         string    `json:"name"`
+		Page         int32     `json:"page"`
+		PosterPath   ImagePath `json:"poster_path,omitempty"`
+		TotalPages   int32     `json:"total_pages"`
+		TotalResults int32     `json:"total_results"`
+	}
+
+	// ListItemStatusResponse represents a list item status response
+	ListItemStatusResponse struct {
+		ID          string `json:"id"`
+		ItemPresent bool   `json:"item_present"`
+	}
+
+	// V4RequestTokenRequest represents the body of a v4 create request token request
+	V4RequestTokenRequest struct {
+		RedirectTo string `json:"redirect_to,omitempty"`
+	}
+
+	// V4RequestTokenResponse represents a v4 request token response
+	V4RequestTokenResponse struct {
+		StatusResponse
+		RequestToken string `json:"request_token"`
+	}
+
+	// V4AccessTokenRequest represents the body of a v4 create access token request
+	V4AccessTokenRequest struct {
+		RequestToken string `json:"request_token"`
+	}
+
+	// V4AccessTokenResponse represents a v4 access token response
+	V4AccessTokenResponse struct {
+		StatusResponse
+		AccessToken string `json:"access_token"`
+		AccountID   string `json:"account_id"`
+	}
+
+	// V4LogoutRequest represents the body of a v4 delete access token request
+	V4LogoutRequest struct {
+		AccessToken string `json:"access_token"`
+	}
+
+	// V4ListItem represents an item of a v4 list, which can be either a movie or a TV show
+	V4ListItem struct {
+		Adult            bool          `json:"adult"`
+		BackdropPath     ImagePath     `json:"backdrop_path,omitempty"`
+		FirstAirDate     Date          `json:"first_air_date"`
+		GenreIDs         []GenreID     `json:"genre_ids"`
+		ID               int32         `json:"id"`
+		MediaType        MediaTypeEnum `json:"media_type"`
+		Name             *string       `json:"name,omitempty"`
+		OriginCountry    []string      `json:"origin_country,omitempty"`
+		OriginalLanguage string        `json:"original_language"`
+		OriginalName     *string       `json:"original_name,omitempty"`
+		OriginalTitle    *string       `json:"original_title,omitempty"`
+		Overview         string        `json:"overview"`
+		Popularity       *float32      `json:"popularity,omitempty"`
+		PosterPath       ImagePath     `json:"poster_path,omitempty"`
+		ReleaseDate      Date          `json:"release_date"`
+		Title            *string       `json:"title,omitempty"`
+		Video            *bool         `json:"video,omitempty"`
+		VoteAverage      *float32      `json:"vote_average,omitempty"`
+		VoteCount        *int32        `json:"vote_count,omitempty"`
+	}
+
+	// V4ListCreator represents the creator of a v4 list
+	V4ListCreator struct {
+		AvatarPath   ImagePath `json:"avatar_path,omitempty"`
+		GravatarHash string    `json:"gravatar_hash"`
+		ID           string    `json:"id"`
+		Name         string    `json:"name"`
+		Username     string    `json:"username"`
+	}
+
+	// V4ListDetailsResponse represents a v4 list details response
+	V4ListDetailsResponse struct {
+		AverageRating *float32          `json:"average_rating,omitempty"`
+		BackdropPath  ImagePath         `json:"backdrop_path,omitempty"`
+		Comments      map[string]string `json:"comments"`
+		CreatedBy     V4ListCreator     `json:"created_by"`
+		Description   string            `json:"description"`
+		ID            int32             `json:"id"`
+		// nolint:revive
+		ISO3166_1 string `json:"iso_3166_1"`
+		// nolint:revive
+		ISO639_1     string            `json:"iso_639_1"`
+		ItemCount    int32             `json:"item_count"`
+		Name         string            `json:"name"`
+		ObjectIDs    map[string]string `json:"object_ids"`
+		Page         int32             `json:"page"`
+		PosterPath   ImagePath         `json:"poster_path,omitempty"`
+		Public       bool              `json:"public"`
+		Results      []V4ListItem      `json:"results"`
+		Revenue      *int64            `json:"revenue,omitempty"`
+		Runtime      *int32            `json:"runtime,omitempty"`
+		SortBy       V4ListSortByEnum  `json:"sort_by"`
+		TotalPages   int32             `json:"total_pages"`
+		TotalResults int32             `json:"total_results"`
+	}
+
+	// V4CreateListRequest represents the body of a v4 create list request
+	V4CreateListRequest struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+		// nolint:revive
+		ISO639_1 string `json:"iso_639_1"`
+		// nolint:revive
+		ISO3166_1 string `json:"iso_3166_1,omitempty"`
+		Public    bool   `json:"public"`
+	}
+
+	// V4CreateListResponse represents a v4 create list response
+	V4CreateListResponse struct {
+		StatusResponse
+		ID int32 `json:"id"`
+	}
+
+	// V4UpdateListRequest represents the body of a v4 update list request, where nil or empty fields are left unchanged
+	V4UpdateListRequest struct {
+		Name        string           `json:"name,omitempty"`
+		Description *string          `json:"description,omitempty"`
+		Public      *bool            `json:"public,omitempty"`
+		SortBy      V4ListSortByEnum `json:"sort_by,omitempty"`
+	}
+
+	// V4ListItemRequest represents an item to be added, updated or removed from a v4 list
+	V4ListItemRequest struct {
+		MediaType MediaTypeEnum `json:"media_type"`
+		MediaID   int32         `json:"media_id"`
+		Comment   string        `json:"comment,omitempty"`
+	}
+
+	// V4ListItemsRequest represents the body of a v4 list items request
+	V4ListItemsRequest struct {
+		Items []V4ListItemRequest `json:"items"`
+	}
+
+	// V4ListItemResult represents the result of adding, updating or removing an item from a v4 list
+	V4ListItemResult struct {
+		MediaType MediaTypeEnum `json:"media_type"`
+		MediaID   int32         `json:"media_id"`
+		Success   bool          `json:"success"`
+	}
+
+	// V4ListItemsResponse represents a v4 list items response
+	V4ListItemsResponse struct {
+		StatusResponse
+		Results []V4ListItemResult `json:"results"`
+	}
+
+	// V4ListItemStatusResponse represents a v4 list item status response
+	V4ListItemStatusResponse struct {
+		StatusResponse
+		ID        int32         `json:"id"`
+		MediaID   int32         `json:"media_id"`
+		MediaType MediaTypeEnum `json:"media_type"`
+	}
+
+	// V4ClearListResponse represents a v4 clear list response
+	V4ClearListResponse struct {
+		StatusResponse
+		ID           int32 `json:"id"`
+		ItemsDeleted int32 `json:"items_deleted"`
+	}
+
+	// V4AccountList represents a list of a v4 account
+	V4AccountList struct {
+		AccountObjectID string    `json:"account_object_id"`
+		Adult           int32     `json:"adult"`
+		AverageRating   *float32  `json:"average_rating,omitempty"`
+		BackdropPath    ImagePath `json:"backdrop_path,omitempty"`
+		CreatedAt       string    `json:"created_at"`
+		Description     string    `json:"description"`
+		Featured        int32     `json:"featured"`
+		ID              int32     `json:"id"`
+		// nolint:revive
+		ISO3166_1 string `json:"iso_3166_1"`
+		// nolint:revive
+		ISO639_1      string    `json:"iso_639_1"`
+		Name          string    `json:"name"`
+		NumberOfItems int32     `json:"number_of_items"`
+		PosterPath    ImagePath `json:"poster_path,omitempty"`
+		Public        int32     `json:"public"`
+		Revenue       *int64    `json:"revenue,omitempty"`
+		Runtime       *string   `json:"runtime,omitempty"`
+		SortBy        int32     `json:"sort_by"`
+		UpdatedAt     string    `json:"updated_at"`
+	}
+
+	// V4AccountListsResponse represents a v4 account lists response
+	V4AccountListsResponse struct {
+		Page         int32           `json:"page"`
+		Results      []V4AccountList `json:"results"`
+		TotalPages   int32           `json:"total_pages"`
+		TotalResults int32           `json:"total_results"`
+	}
+
+	// AccountStateRating represents the rating given by an account, which TMDB encodes as false when not rated
+	AccountStateRating struct {
+		Rated bool
+		Value float32
+	}
+
+	// MovieAccountStatesResponse represents a movie account states response
+	MovieAccountStatesResponse struct {
+		ID        MovieID            `json:"id"`
+		Favorite  bool               `json:"favorite"`
+		Rated     AccountStateRating `json:"rated"`
+		Watchlist bool               `json:"watchlist"`
+	}
+
+	// KeywordMoviesResponse represents the response of the movies tagged with a keyword
+	KeywordMoviesResponse struct {
+		ID           KeywordID     `json:"id"`
+		Page         int32         `json:"page"`
+		Results      []SimpleMovie `json:"results"`
+		TotalPages   int32         `json:"total_pages"`
+		TotalResults int32         `json:"total_results"`
+	}
+
+	// FieldLanguages represents the languages the localized fields of a movie come from, where an empty language means
+	// the field is empty in every language of the fallback chain
+	FieldLanguages struct {
+		Title    string
+		Overview string
+		Tagline  string
+	}
+
+	// MovieTranslationData represents the localized fields of a movie translation
+	MovieTranslationData struct {
+		Homepage string `json:"homepage"`
+		Overview string `json:"overview"`
+		Runtime  int32  `json:"runtime"`
+		Tagline  string `json:"tagline"`
+		Title    string `json:"title"`
+	}
+
+	// MovieTranslation represents a translation of a movie
+	MovieTranslation struct {
+		// nolint:revive
+		ISO3166_1 string `json:"iso_3166_1"`
+		// nolint:revive
+		ISO639_1    string               `json:"iso_639_1"`
+		Name        string               `json:"name"`
+		EnglishName string               `json:"english_name"`
+		Data        MovieTranslationData `json:"data"`
+	}
+
+	// MovieTranslationsResponse represents the response of the translations of a movie
+	MovieTranslationsResponse struct {
+		ID           MovieID            `json:"id"`
+		Translations []MovieTranslation `json:"translations"`
+	}
 )
+
+// IDs returns the IDs of the changed items
+//
+// Returns:
+//
+// - []int32: the IDs of the changed items
+func (c ChangeListResponse) IDs() []int32 {
+	ids := make([]int32, len(c.Results))
+	for i, item := range c.Results {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+// Keys returns the keys of the fields that have changed
+//
+// Returns:
+//
+// - []string: the keys of the changed fields
+func (c ChangesResponse) Keys() []string {
+	keys := make([]string, len(c.Changes))
+	for i, change := range c.Changes {
+		keys[i] = change.Key
+	}
+	return keys
+}
+
+// successful returns whether the TMDB API reported the request as successful
+//
+// Returns:
+//
+// - bool: true if the request was successful
+// - int32: the TMDB status code
+// - string: the TMDB status message
+func (s StatusResponse) successful() (bool, int32, string) {
+	return s.Success, s.StatusCode, s.StatusMessage
+}
+
+// Created returns whether the TMDB API reported the item as created
+//
+// Returns:
+//
+// - bool: true if the item was created
+func (s StatusResponse) Created() bool {
+	return s.Success && s.StatusCode == StatusCodeSuccess
+}
+
+// Updated returns whether the TMDB API reported the item as updated
+//
+// Returns:
+//
+// - bool: true if the item was updated
+func (s StatusResponse) Updated() bool {
+	return s.Success && s.StatusCode == StatusCodeItemUpdated
+}
+
+// Deleted returns whether the TMDB API reported the item as deleted
+//
+// Returns:
+//
+// - bool: true if the item was deleted
+func (s StatusResponse) Deleted() bool {
+	return s.Success && s.StatusCode == StatusCodeItemDeleted
+}
+
+// UnmarshalJSON unmarshals the account state rating, which is either false or an object with the rating value
+//
+// Parameters:
+//
+// - data: the JSON data
+//
+// Returns:
+//
+// - error: if there was an error unmarshalling the rating
+func (a *AccountStateRating) UnmarshalJSON(data []byte) error {
+	// Check if the item has not been rated
+	var rated bool
+	if err := json.Unmarshal(data, &rated); err == nil {
+		*a = AccountStateRating{Rated: rated}
+		return nil
+	}
+
+	// Parse the rating value
+	var rating struct {
+		Value float32 `json:"value"`
+	}
+	if err := json.Unmarshal(data, &rating); err != nil {
+		return err
+	}
+	*a = AccountStateRating{Rated: true, Value: rating.Value}
+	return nil
+}
+
+// MarshalJSON marshals the account state rating using the same encoding as the TMDB API
+//
+// Returns:
+//
+// - []byte: the JSON data
+// - error: if there was an error marshalling the rating
+func (a AccountStateRating) MarshalJSON() ([]byte, error) {
+	if !a.Rated {
+		return []byte("false"), nil
+	}
+	return json.Marshal(
+		struct {
+			Value float32 `json:"value"`
+		}{Value: a.Value},
+	)
+}
+
+// FindEpisode finds the TMDB episode placed at the given position of the episode group ordering
+//
+// Parameters:
+//
+// - groupOrder: the order of the group within the episode group, such as the alternative season number
+// - episodeOrder: the order of the episode within the group
+//
+// Returns:
+//
+// - *EpisodeGroupEpisode: the TMDB episode, or nil if there is no episode at the given position
+func (t TVEpisodeGroupDetailsResponse) FindEpisode(groupOrder, episodeOrder int32) *EpisodeGroupEpisode {
+	for i := range t.Groups {
+		if t.Groups[i].Order != groupOrder {
+			continue
+		}
+		for j := range t.Groups[i].Episodes {
+			if t.Groups[i].Episodes[j].Order == episodeOrder {
+				return &t.Groups[i].Episodes[j]
+			}
+		}
+	}
+	return nil
+}
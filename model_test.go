@@ -0,0 +1,65 @@
+package gotmdbapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestMovieAccountStatesResponseUnmarshal tests the unmarshalling of the rated field of the movie account states
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestMovieAccountStatesResponseUnmarshal(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		data      string
+		wantRated bool
+		wantValue float32
+	}{
+		{name: "not rated", data: `{"id":550,"rated":false}`},
+		{name: "rated", data: `{"id":550,"rated":{"value":8.5}}`, wantRated: true, wantValue: 8.5},
+	} {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				var resp MovieAccountStatesResponse
+				if err := json.Unmarshal([]byte(tc.data), &resp); err != nil {
+					t.Fatalf("Failed to unmarshal movie account states: %v", err)
+				}
+				if resp.Rated.Rated != tc.wantRated || resp.Rated.Value != tc.wantValue {
+					t.Fatalf("Unexpected rating %+v", resp.Rated)
+				}
+			},
+		)
+	}
+}
+
+// TestTVEpisodeGroupDetailsResponseFindEpisode tests the lookup of episodes by their episode group ordering
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestTVEpisodeGroupDetailsResponseFindEpisode(t *testing.T) {
+	data := `{"id":"5acf93e60e0a26346d0000ce","groups":[{"order":1,"episodes":[
+		{"order":0,"id":10,"season_number":1,"episode_number":1},
+		{"order":1,"id":11,"season_number":2,"episode_number":5}
+	]}]}`
+	var resp TVEpisodeGroupDetailsResponse
+	if err := json.Unmarshal([]byte(data), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal episode group details: %v", err)
+	}
+
+	// Check the episode is found at its alternative position
+	episode := resp.FindEpisode(1, 1)
+	if episode == nil {
+		t.Fatal("FindEpisode returned nil episode")
+	}
+	if episode.SeasonNumber != 2 || episode.EpisodeNumber != 5 {
+		t.Fatalf("FindEpisode returned S%02dE%02d, expected S02E05", episode.SeasonNumber, episode.EpisodeNumber)
+	}
+
+	// Check a missing position returns nil
+	if resp.FindEpisode(2, 0) != nil {
+		t.Fatal("FindEpisode returned an episode for a missing group")
+	}
+}
@@ -1,55 +1,209 @@
 package gotmdbapi
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type (
-	// DiscoverMoviesQueryParameters represents the query parameters for discovering movies
+	// IDFilter represents a boolean composition of IDs or enum values for the TMDB API list filters, such as
+	// with_genres or with_release_type
+	IDFilter[T any] struct {
+		ids       []T
+		separator string
+	}
+
+	// PageOptions represents the query parameters for paginated endpoints
+	PageOptions struct {
+		Language string `tmdb:"language,omitempty"`
+		Page     int32  `tmdb:"page,omitempty"`
+	}
+
+	// MovieListOptions represents the query parameters for the movie lists, such as now playing or popular movies
+	MovieListOptions struct {
+		Language string `tmdb:"language,omitempty"`
+		Page     int32  `tmdb:"page,omitempty"`
+		Region   string `tmdb:"region,omitempty"`
+	}
+
+	// SearchMoviesOptions represents the query parameters for searching movies, where a nil IncludeAdult is not sent
+	SearchMoviesOptions struct {
+		IncludeAdult       *bool  `tmdb:"include_adult"`
+		Language           string `tmdb:"language,omitempty"`
+		PrimaryReleaseYear int32  `tmdb:"primary_release_year,omitempty"`
+		Page               int32  `tmdb:"page,omitempty"`
+		Region             string `tmdb:"region,omitempty"`
+		Year               int32  `tmdb:"year,omitempty"`
+	}
+
+	// KeywordMoviesOptions represents the query parameters for the movies tagged with a keyword, where a nil
+	// IncludeAdult is not sent
+	KeywordMoviesOptions struct {
+		IncludeAdult *bool  `tmdb:"include_adult"`
+		Language     string `tmdb:"language,omitempty"`
+		Page         int32  `tmdb:"page,omitempty"`
+	}
+
+	// DiscoverMoviesQueryParameters represents the query parameters for discovering movies, where nil pointers, empty
+	// strings, zero dates and empty filters are not sent
 	DiscoverMoviesQueryParameters struct {
-		Certification              string
-		CertificationCountry       string
-		CertificationGTE           string
-		CertificationLTE           string
-		IncludeAdult               bool
-		IncludeVideo               bool
-		Language                   string
-		PrimaryReleaseYear         int32
-		PrimaryReleaseYearGTE      int32
-		PrimaryReleaseYearLTE      int32
-		Page                       int32
-		Region                     string
-		ReleaseDateGTE             string
-		ReleaseDateLTE             string
-		SortBy                     SortByEnum
-		VoteAverageGTE             float32
-		VoteAverageLTE             float32
-		VoteCountGTE               float32
-		VoteCountLTE               float32
-		WithGenres                 []string
-		WithCompanies              []string
-		WithKeywords               []string
-		WithCast                   []string
-		WithCrew                   []string
-		WithPeople                 []string
-		WithOriginCountry          string
-		WithOriginalLanguage       string
-		WatchRegion                string
-		WithRuntimeGTE             int32
-		WithRuntimeLTE             int32
-		WithWatchMonetizationTypes []WatchMonetizationTypeEnums
-		WithWatchProviders         []string
-		WithoutCompanies           string
-		WithoutGenres              string
-		WithoutKeywords            string
-		Year                       int32
+		Certification              string                               `tmdb:"certification,omitempty"`
+		CertificationCountry       string                               `tmdb:"certification_country,omitempty"`
+		CertificationGTE           string                               `tmdb:"certification.gte,omitempty"`
+		CertificationLTE           string                               `tmdb:"certification.lte,omitempty"`
+		IncludeAdult               *bool                                `tmdb:"include_adult"`
+		IncludeVideo               *bool                                `tmdb:"include_video"`
+		Language                   string                               `tmdb:"language,omitempty"`
+		PrimaryReleaseYear         *int32                               `tmdb:"primary_release_year"`
+		PrimaryReleaseYearGTE      *int32                               `tmdb:"primary_release_year.gte"`
+		PrimaryReleaseYearLTE      *int32                               `tmdb:"primary_release_year.lte"`
+		Page                       int32                                `tmdb:"page,omitempty"`
+		Region                     string                               `tmdb:"region,omitempty"`
+		ReleaseDateGTE             Date                                 `tmdb:"release_date.gte"`
+		ReleaseDateLTE             Date                                 `tmdb:"release_date.lte"`
+		SortBy                     SortByEnum                           `tmdb:"sort_by,omitempty"`
+		VoteAverageGTE             *float32                             `tmdb:"vote_average.gte"`
+		VoteAverageLTE             *float32                             `tmdb:"vote_average.lte"`
+		VoteCountGTE               *float32                             `tmdb:"vote_count.gte"`
+		VoteCountLTE               *float32                             `tmdb:"vote_count.lte"`
+		WithGenres                 IDFilter[GenreID]                    `tmdb:"with_genres"`
+		WithCompanies              IDFilter[CompanyID]                  `tmdb:"with_companies"`
+		WithKeywords               IDFilter[KeywordID]                  `tmdb:"with_keywords"`
+		WithCast                   IDFilter[PersonID]                   `tmdb:"with_cast"`
+		WithCrew                   IDFilter[PersonID]                   `tmdb:"with_crew"`
+		WithPeople                 IDFilter[PersonID]                   `tmdb:"with_people"`
+		WithOriginCountry          string                               `tmdb:"with_origin_country,omitempty"`
+		WithOriginalLanguage       string                               `tmdb:"with_original_language,omitempty"`
+		WatchRegion                string                               `tmdb:"watch_region,omitempty"`
+		WithReleaseType            IDFilter[ReleaseTypeEnum]            `tmdb:"with_release_type"`
+		WithRuntimeGTE             *int32                               `tmdb:"with_runtime.gte"`
+		WithRuntimeLTE             *int32                               `tmdb:"with_runtime.lte"`
+		WithWatchMonetizationTypes IDFilter[WatchMonetizationTypeEnums] `tmdb:"with_watch_monetization_types"`
+		WithWatchProviders         IDFilter[WatchProviderID]            `tmdb:"with_watch_providers"`
+		WithoutCompanies           IDFilter[CompanyID]                  `tmdb:"without_companies"`
+		WithoutGenres              IDFilter[GenreID]                    `tmdb:"without_genres"`
+		WithoutKeywords            IDFilter[KeywordID]                  `tmdb:"without_keywords"`
+		WithoutWatchProviders      IDFilter[WatchProviderID]            `tmdb:"without_watch_providers"`
+		Year                       *int32                               `tmdb:"year"`
 	}
 )
 
+// AllOf creates a filter that matches the items related to all the given IDs
+//
+// Parameters:
+//
+// - ids: the IDs
+//
+// Returns:
+//
+// - IDFilter[T]: the filter
+func AllOf[T any](ids ...T) IDFilter[T] {
+	return IDFilter[T]{
+		ids:       ids,
+		separator: AndSeparator,
+	}
+}
+
+// AnyOf creates a filter that matches the items related to any of the given IDs
+//
+// Parameters:
+//
+// - ids: the IDs
+//
+// Returns:
+//
+// - IDFilter[T]: the filter
+func AnyOf[T any](ids ...T) IDFilter[T] {
+	return IDFilter[T]{
+		ids:       ids,
+		separator: OrSeparator,
+	}
+}
+
+// IDs returns the IDs of the filter
+//
+// Returns:
+//
+// - []T: the IDs
+func (f IDFilter[T]) IDs() []T {
+	return f.ids
+}
+
+// IsAnyOf returns whether the filter matches the items related to any of its IDs
+//
+// Returns:
+//
+// - bool: true if the filter is an OR composition
+func (f IDFilter[T]) IsAnyOf() bool {
+	return f.separator == OrSeparator
+}
+
+// IsEmpty returns whether the filter has no IDs
+//
+// Returns:
+//
+// - bool: true if the filter has no IDs
+func (f IDFilter[T]) IsEmpty() bool {
+	return len(f.ids) == 0
+}
+
+// String returns the filter encoded as expected by the TMDB API, with the IDs separated by commas (AND) or pipes (OR)
+//
+// Returns:
+//
+// - string: the encoded filter
+func (f IDFilter[T]) String() string {
+	values := make([]string, len(f.ids))
+	for i, id := range f.ids {
+		values[i], _ = formatQueryScalar(reflect.ValueOf(id))
+	}
+	return strings.Join(values, f.separator)
+}
+
+// UnmarshalText parses a filter encoded as expected by the TMDB API, with the IDs separated by commas (AND) or pipes
+// (OR)
+//
+// Parameters:
+//
+// - text: the encoded filter
+//
+// Returns:
+//
+// - error: if the filter mixes both separators or any of its IDs could not be parsed
+func (f *IDFilter[T]) UnmarshalText(text []byte) error {
+	value := string(text)
+	if value == "" {
+		*f = IDFilter[T]{}
+		return nil
+	}
+
+	// Detect the separator, since TMDB does not support mixing both compositions
+	separator := AndSeparator
+	if strings.Contains(value, OrSeparator) {
+		if strings.Contains(value, AndSeparator) {
+			return ErrMixedIDFilter
+		}
+		separator = OrSeparator
+	}
+
+	parts := strings.Split(value, separator)
+	ids := make([]T, len(parts))
+	for i, part := range parts {
+		if err := parseQueryScalar(reflect.ValueOf(&ids[i]).Elem(), strings.TrimSpace(part)); err != nil {
+			return err
+		}
+	}
+	*f = IDFilter[T]{ids: ids, separator: separator}
+	return nil
+}
+
 // AddLanguageQueryParameter adds the language query parameter to the HTTP request
 //
 // Parameters:
@@ -78,19 +232,6 @@ func AddIncludeAdultQueryParameter(
 	query.Add(IncludeAdult, strconv.FormatBool(includeAdult))
 }
 
-// AddIncludeVideoQueryParameter adds the include video query parameter to the HTTP request
-//
-// Parameters:
-//
-// - query: the HTTP request query parameters
-// - includeVideo: whether to include video content
-func AddIncludeVideoQueryParameter(
-	query url.Values,
-	includeVideo bool,
-) {
-	query.Add(IncludeVideo, strconv.FormatBool(includeVideo))
-}
-
 // AddPageQueryParameter adds the page query parameter to the HTTP request
 //
 // Parameters:
@@ -136,51 +277,66 @@ func AddPrimaryReleaseYearQueryParameter(
 	}
 }
 
-// AddPrimaryReleaseYearGTEQueryParameter adds the primary release year greater than or equal to query parameter to the
-// HTTP request
+// AddYearQueryParameter adds the year query parameter to the HTTP request
 //
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - primaryReleaseYearGTE: the primary release year greater than or equal to (optional)
-func AddPrimaryReleaseYearGTEQueryParameter(
+// - year: the year (optional)
+func AddYearQueryParameter(
 	query url.Values,
-	primaryReleaseYearGTE int32,
+	year int32,
 ) {
-	if primaryReleaseYearGTE > 0 {
-		query.Add(PrimaryReleaseYearGTE, fmt.Sprintf("%d", primaryReleaseYearGTE))
+	if year > 0 {
+		query.Add(Year, fmt.Sprintf("%d", year))
 	}
 }
 
-// AddPrimaryReleaseYearLTEQueryParameter adds the primary release year less than or equal to query parameter to the
+// AddIncludeVideoQueryParameter adds the include video query parameter to the HTTP request
+//
+// Parameters:
+//
+// - query: the HTTP request query parameters
+// - includeVideo: whether to include video content (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
+func AddIncludeVideoQueryParameter(
+	query url.Values,
+	includeVideo *bool,
+) {
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{IncludeVideo: includeVideo})
+}
+
+// AddPrimaryReleaseYearGTEQueryParameter adds the primary release year greater than or equal to query parameter to the
 // HTTP request
 //
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - primaryReleaseYearLTE: the primary release year less than or equal to (optional)
-func AddPrimaryReleaseYearLTEQueryParameter(
+// - primaryReleaseYearGTE: the primary release year greater than or equal to (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
+func AddPrimaryReleaseYearGTEQueryParameter(
 	query url.Values,
-	primaryReleaseYearLTE int32,
+	primaryReleaseYearGTE *int32,
 ) {
-	if primaryReleaseYearLTE > 0 {
-		query.Add(PrimaryReleaseYearLTE, fmt.Sprintf("%d", primaryReleaseYearLTE))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{PrimaryReleaseYearGTE: primaryReleaseYearGTE})
 }
 
-// AddYearQueryParameter adds the year query parameter to the HTTP request
+// AddPrimaryReleaseYearLTEQueryParameter adds the primary release year less than or equal to query parameter to the
+// HTTP request
 //
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - year: the year (optional)
-func AddYearQueryParameter(
+// - primaryReleaseYearLTE: the primary release year less than or equal to (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
+func AddPrimaryReleaseYearLTEQueryParameter(
 	query url.Values,
-	year int32,
+	primaryReleaseYearLTE *int32,
 ) {
-	if year > 0 {
-		query.Add(Year, fmt.Sprintf("%d", year))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{PrimaryReleaseYearLTE: primaryReleaseYearLTE})
 }
 
 // AddCertificationQueryParameter adds the certification query parameter to the HTTP request query parameters
@@ -188,12 +344,14 @@ func AddYearQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - certification: the certification value
+// - certification: the certification value (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddCertificationQueryParameter(
 	query url.Values,
 	certification string,
 ) {
-	query.Add(Certification, certification)
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{Certification: certification})
 }
 
 // AddCertificationCountryQueryParameter adds the certification country query parameter to the HTTP request query
@@ -201,13 +359,15 @@ func AddCertificationQueryParameter(
 //
 // Parameters:
 //
-// - req: the HTTP request query parameters
-// - certificationCountry: the certification country value
+// - query: the HTTP request query parameters
+// - certificationCountry: the certification country value (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddCertificationCountryQueryParameter(
 	query url.Values,
 	certificationCountry string,
 ) {
-	query.Add(CertificationCountry, certificationCountry)
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{CertificationCountry: certificationCountry})
 }
 
 // AddCertificationGTEQueryParameter adds the certification.gte query parameter to the HTTP request query parameters
@@ -215,12 +375,14 @@ func AddCertificationCountryQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - certificationGTE: the certification.gte value
+// - certificationGTE: the certification.gte value (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddCertificationGTEQueryParameter(
 	query url.Values,
 	certificationGTE string,
 ) {
-	query.Add(CerificationGTE, certificationGTE)
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{CertificationGTE: certificationGTE})
 }
 
 // AddCertificationLTEQueryParameter adds the certification.lte query parameter to the HTTP request query parameters
@@ -228,12 +390,14 @@ func AddCertificationGTEQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - certificationLTE: the certification.lte value
+// - certificationLTE: the certification.lte value (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddCertificationLTEQueryParameter(
 	query url.Values,
 	certificationLTE string,
 ) {
-	query.Add(CertificationLTE, certificationLTE)
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{CertificationLTE: certificationLTE})
 }
 
 // AddReleaseDateGTEQueryParameter adds the release_date.gte query parameter to the HTTP request query parameters
@@ -241,12 +405,14 @@ func AddCertificationLTEQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - releaseDateGTE: the release_date.gte value
+// - releaseDateGTE: the release_date.gte value (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddReleaseDateGTEQueryParameter(
 	query url.Values,
-	releaseDateGTE string,
+	releaseDateGTE Date,
 ) {
-	query.Add(ReleaseDateGTE, releaseDateGTE)
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{ReleaseDateGTE: releaseDateGTE})
 }
 
 // AddReleaseDateLTEQueryParameter adds the release_date.lte query parameter to the HTTP request query parameters
@@ -254,12 +420,14 @@ func AddReleaseDateGTEQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - releaseDateLTE: the release_date.lte value
+// - releaseDateLTE: the release_date.lte value (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddReleaseDateLTEQueryParameter(
 	query url.Values,
-	releaseDateLTE string,
+	releaseDateLTE Date,
 ) {
-	query.Add(ReleaseDateLTE, releaseDateLTE)
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{ReleaseDateLTE: releaseDateLTE})
 }
 
 // AddSortByQueryParameter adds the sort_by query parameter to the HTTP request
@@ -268,13 +436,13 @@ func AddReleaseDateLTEQueryParameter(
 //
 // - query: the HTTP request query parameters
 // - sortBy: the sort by value
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddSortByQueryParameter(
 	query url.Values,
 	sortBy SortByEnum,
 ) {
-	if sortBy != "" {
-		query.Add(SortBy, string(sortBy))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{SortBy: sortBy})
 }
 
 // AddVoteAverageGTEQueryParameter adds the vote_average.gte query parameter to the HTTP request
@@ -282,14 +450,14 @@ func AddSortByQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - voteAverageGTE: the vote average greater than or equal to value
+// - voteAverageGTE: the vote average greater than or equal to value (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddVoteAverageGTEQueryParameter(
 	query url.Values,
-	voteAverageGTE float32,
+	voteAverageGTE *float32,
 ) {
-	if voteAverageGTE > 0 {
-		query.Add(VoteAverageGTE, fmt.Sprintf("%.1f", voteAverageGTE))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{VoteAverageGTE: voteAverageGTE})
 }
 
 // AddVoteAverageLTEQueryParameter adds the vote_average.lte query parameter to the HTTP request
@@ -297,14 +465,14 @@ func AddVoteAverageGTEQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - voteAverageLTE: the vote average less than or equal to value
+// - voteAverageLTE: the vote average less than or equal to value (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddVoteAverageLTEQueryParameter(
 	query url.Values,
-	voteAverageLTE float32,
+	voteAverageLTE *float32,
 ) {
-	if voteAverageLTE > 0 {
-		query.Add(VoteAverageLTE, fmt.Sprintf("%.1f", voteAverageLTE))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{VoteAverageLTE: voteAverageLTE})
 }
 
 // AddVoteCountGTEQueryParameter adds the vote_count.gte query parameter to the HTTP request
@@ -312,14 +480,14 @@ func AddVoteAverageLTEQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - voteCountGTE: the vote count greater than or equal to value
+// - voteCountGTE: the vote count greater than or equal to value (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddVoteCountGTEQueryParameter(
 	query url.Values,
-	voteCountGTE float32,
+	voteCountGTE *float32,
 ) {
-	if voteCountGTE > 0 {
-		query.Add(VoteCountGTE, fmt.Sprintf("%.1f", voteCountGTE))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{VoteCountGTE: voteCountGTE})
 }
 
 // AddVoteCountLTEQueryParameter adds the vote_count.lte query parameter to the HTTP request
@@ -327,14 +495,14 @@ func AddVoteCountGTEQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - voteCountLTE: the vote count less than or equal to value
+// - voteCountLTE: the vote count less than or equal to value (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddVoteCountLTEQueryParameter(
 	query url.Values,
-	voteCountLTE float32,
+	voteCountLTE *float32,
 ) {
-	if voteCountLTE > 0 {
-		query.Add(VoteCountLTE, fmt.Sprintf("%.1f", voteCountLTE))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{VoteCountLTE: voteCountLTE})
 }
 
 // AddWithGenresQueryParameter adds the with_genres query parameter to the HTTP request
@@ -342,14 +510,14 @@ func AddVoteCountLTEQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - withGenres: the list of genre IDs
+// - withGenres: the filter of the genre IDs, built with AllOf or AnyOf
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithGenresQueryParameter(
 	query url.Values,
-	withGenres []string,
+	withGenres IDFilter[GenreID],
 ) {
-	if len(withGenres) > 0 {
-		query.Add(WithGenres, url.QueryEscape(strings.Join(withGenres, ",")))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithGenres: withGenres})
 }
 
 // AddWithCompaniesQueryParameter adds the with_companies query parameter to the HTTP request
@@ -357,14 +525,14 @@ func AddWithGenresQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - withCompanies: the list of company IDs
+// - withCompanies: the filter of the company IDs, built with AllOf or AnyOf
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithCompaniesQueryParameter(
 	query url.Values,
-	withCompanies []string,
+	withCompanies IDFilter[CompanyID],
 ) {
-	if len(withCompanies) > 0 {
-		query.Add(WithCompanies, url.QueryEscape(strings.Join(withCompanies, ",")))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithCompanies: withCompanies})
 }
 
 // AddWithKeywordsQueryParameter adds the with_keywords query parameter to the HTTP request
@@ -372,14 +540,14 @@ func AddWithCompaniesQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - withKeywords: the list of keyword IDs
+// - withKeywords: the filter of the keyword IDs, built with AllOf or AnyOf
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithKeywordsQueryParameter(
 	query url.Values,
-	withKeywords []string,
+	withKeywords IDFilter[KeywordID],
 ) {
-	if len(withKeywords) > 0 {
-		query.Add(WithKeywords, url.QueryEscape(strings.Join(withKeywords, ",")))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithKeywords: withKeywords})
 }
 
 // AddWithCastQueryParameter adds the with_cast query parameter to the HTTP request
@@ -387,14 +555,14 @@ func AddWithKeywordsQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - withCast: the list of cast IDs
+// - withCast: the filter of the cast IDs, built with AllOf or AnyOf
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithCastQueryParameter(
 	query url.Values,
-	withCast []string,
+	withCast IDFilter[PersonID],
 ) {
-	if len(withCast) > 0 {
-		query.Add(WithCast, url.QueryEscape(strings.Join(withCast, ",")))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithCast: withCast})
 }
 
 // AddWithCrewQueryParameter adds the with_crew query parameter to the HTTP request
@@ -402,14 +570,14 @@ func AddWithCastQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - withCrew: the list of crew IDs
+// - withCrew: the filter of the crew IDs, built with AllOf or AnyOf
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithCrewQueryParameter(
 	query url.Values,
-	withCrew []string,
+	withCrew IDFilter[PersonID],
 ) {
-	if len(withCrew) > 0 {
-		query.Add(WithCrew, url.QueryEscape(strings.Join(withCrew, ",")))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithCrew: withCrew})
 }
 
 // AddWithPeopleQueryParameter adds the with_people query parameter to the HTTP request
@@ -417,14 +585,14 @@ func AddWithCrewQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - withPeople: the list of people IDs
+// - withPeople: the filter of the people IDs, built with AllOf or AnyOf
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithPeopleQueryParameter(
 	query url.Values,
-	withPeople []string,
+	withPeople IDFilter[PersonID],
 ) {
-	if len(withPeople) > 0 {
-		query.Add(WithPeople, url.QueryEscape(strings.Join(withPeople, ",")))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithPeople: withPeople})
 }
 
 // AddWithOriginCountryQueryParameter adds the with_origin_country query parameter to the HTTP request
@@ -433,13 +601,13 @@ func AddWithPeopleQueryParameter(
 //
 // - query: the HTTP request query parameters
 // - withOriginCountry: the origin country code
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithOriginCountryQueryParameter(
 	query url.Values,
 	withOriginCountry string,
 ) {
-	if withOriginCountry != "" {
-		query.Add(WithOriginCountry, withOriginCountry)
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithOriginCountry: withOriginCountry})
 }
 
 // AddWithOriginalLanguageQueryParameter adds the with_original_language query parameter to the HTTP request
@@ -448,13 +616,13 @@ func AddWithOriginCountryQueryParameter(
 //
 // - query: the HTTP request query parameters
 // - withOriginalLanguage: the original language code
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithOriginalLanguageQueryParameter(
 	query url.Values,
 	withOriginalLanguage string,
 ) {
-	if withOriginalLanguage != "" {
-		query.Add(WithOriginalLanguage, withOriginalLanguage)
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithOriginalLanguage: withOriginalLanguage})
 }
 
 // AddWatchRegionQueryParameter adds the watch_region query parameter to the HTTP request
@@ -463,13 +631,29 @@ func AddWithOriginalLanguageQueryParameter(
 //
 // - query: the HTTP request query parameters
 // - watchRegion: the watch region code
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWatchRegionQueryParameter(
 	query url.Values,
 	watchRegion string,
 ) {
-	if watchRegion != "" {
-		query.Add(WatchRegion, watchRegion)
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WatchRegion: watchRegion})
+}
+
+// AddWithReleaseTypeQueryParameter adds the with_release_type query parameter to the HTTP request, matching any of the
+// given release types
+//
+// Parameters:
+//
+// - query: the HTTP request query parameters
+// - withReleaseType: the list of release types
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
+func AddWithReleaseTypeQueryParameter(
+	query url.Values,
+	withReleaseType []ReleaseTypeEnum,
+) {
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithReleaseType: AnyOf(withReleaseType...)})
 }
 
 // AddWithRuntimeGTEQueryParameter adds the with_runtime.gte query parameter to the HTTP request
@@ -477,14 +661,14 @@ func AddWatchRegionQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - withRuntimeGTE: the runtime greater than or equal to value
+// - withRuntimeGTE: the runtime greater than or equal to value (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithRuntimeGTEQueryParameter(
 	query url.Values,
-	withRuntimeGTE int32,
+	withRuntimeGTE *int32,
 ) {
-	if withRuntimeGTE > 0 {
-		query.Add(WithRuntimeGTE, fmt.Sprintf("%d", withRuntimeGTE))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithRuntimeGTE: withRuntimeGTE})
 }
 
 // AddWithRuntimeLTEQueryParameter adds the with_runtime.lte query parameter to the HTTP request
@@ -492,14 +676,14 @@ func AddWithRuntimeGTEQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - withRuntimeLTE: the runtime less than or equal to value
+// - withRuntimeLTE: the runtime less than or equal to value (optional)
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithRuntimeLTEQueryParameter(
 	query url.Values,
-	withRuntimeLTE int32,
+	withRuntimeLTE *int32,
 ) {
-	if withRuntimeLTE > 0 {
-		query.Add(WithRuntimeLTE, fmt.Sprintf("%d", withRuntimeLTE))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithRuntimeLTE: withRuntimeLTE})
 }
 
 // AddWithWatchMonetizationTypesQueryParameter adds the with_watch_monetization_types query parameter to the HTTP
@@ -509,17 +693,16 @@ func AddWithRuntimeLTEQueryParameter(
 //
 // - query: the HTTP request query parameters
 // - withWatchMonetizationTypes: the list of watch monetization types
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithWatchMonetizationTypesQueryParameter(
 	query url.Values,
 	withWatchMonetizationTypes []WatchMonetizationTypeEnums,
 ) {
-	if len(withWatchMonetizationTypes) > 0 {
-		strTypes := make([]string, len(withWatchMonetizationTypes))
-		for i, t := range withWatchMonetizationTypes {
-			strTypes[i] = string(t)
-		}
-		query.Add(WithWatchMonetizationTypes, url.QueryEscape(strings.Join(strTypes, ",")))
-	}
+	encodeDiscoverMoviesQueryParameter(
+		query,
+		DiscoverMoviesQueryParameters{WithWatchMonetizationTypes: AnyOf(withWatchMonetizationTypes...)},
+	)
 }
 
 // AddWithWatchProvidersQueryParameter adds the with_watch_providers query parameter to the HTTP request
@@ -527,14 +710,14 @@ func AddWithWatchMonetizationTypesQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - withWatchProviders: the list of watch provider IDs
+// - withWatchProviders: the filter of the watch provider IDs, built with AllOf or AnyOf
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithWatchProvidersQueryParameter(
 	query url.Values,
-	withWatchProviders []string,
+	withWatchProviders IDFilter[WatchProviderID],
 ) {
-	if len(withWatchProviders) > 0 {
-		query.Add(WithWatchProviders, url.QueryEscape(strings.Join(withWatchProviders, ",")))
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithWatchProviders: withWatchProviders})
 }
 
 // AddWithoutCompaniesQueryParameter adds the without_companies query parameter to the HTTP request
@@ -542,14 +725,14 @@ func AddWithWatchProvidersQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - withoutCompanies: the list of company IDs to exclude
+// - withoutCompanies: the filter of the company IDs to exclude, built with AllOf or AnyOf
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithoutCompaniesQueryParameter(
 	query url.Values,
-	withoutCompanies string,
+	withoutCompanies IDFilter[CompanyID],
 ) {
-	if withoutCompanies != "" {
-		query.Add(WithoutCompanies, withoutCompanies)
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithoutCompanies: withoutCompanies})
 }
 
 // AddWithoutGenresQueryParameter adds the without_genres query parameter to the HTTP request
@@ -557,14 +740,14 @@ func AddWithoutCompaniesQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - withoutGenres: the list of genre IDs to exclude
+// - withoutGenres: the filter of the genre IDs to exclude, built with AllOf or AnyOf
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithoutGenresQueryParameter(
 	query url.Values,
-	withoutGenres string,
+	withoutGenres IDFilter[GenreID],
 ) {
-	if withoutGenres != "" {
-		query.Add(WithoutGenres, withoutGenres)
-	}
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithoutGenres: withoutGenres})
 }
 
 // AddWithoutKeywordsQueryParameter adds the without_keywords query parameter to the HTTP request
@@ -572,14 +755,407 @@ func AddWithoutGenresQueryParameter(
 // Parameters:
 //
 // - query: the HTTP request query parameters
-// - withoutKeywords: the list of keyword IDs to exclude
+// - withoutKeywords: the filter of the keyword IDs to exclude, built with AllOf or AnyOf
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
 func AddWithoutKeywordsQueryParameter(
 	query url.Values,
-	withoutKeywords string,
+	withoutKeywords IDFilter[KeywordID],
+) {
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithoutKeywords: withoutKeywords})
+}
+
+// AddWithoutWatchProvidersQueryParameter adds the without_watch_providers query parameter to the HTTP request
+//
+// Parameters:
+//
+// - query: the HTTP request query parameters
+// - withoutWatchProviders: the filter of the watch provider IDs to exclude, built with AllOf or AnyOf
+//
+// Deprecated: use EncodeQueryParameters with DiscoverMoviesQueryParameters instead.
+func AddWithoutWatchProvidersQueryParameter(
+	query url.Values,
+	withoutWatchProviders IDFilter[WatchProviderID],
+) {
+	encodeDiscoverMoviesQueryParameter(query, DiscoverMoviesQueryParameters{WithoutWatchProviders: withoutWatchProviders})
+}
+
+// encodeDiscoverMoviesQueryParameter adds the set query parameters for discovering movies to the HTTP request query
+// parameters, on behalf of the deprecated helpers of each query parameter
+//
+// Parameters:
+//
+// - query: the HTTP request query parameters
+// - params: the query parameters for discovering movies, with a single field set
+func encodeDiscoverMoviesQueryParameter(query url.Values, params DiscoverMoviesQueryParameters) {
+	// The query parameters for discovering movies only have supported types, so they are always encoded
+	_ = EncodeQueryParameters(query, params)
+}
+
+// AddSessionQueryParameter adds the session_id or guest_session_id query parameter to the HTTP request
+//
+// Parameters:
+//
+// - query: the HTTP request query parameters
+// - session: the TMDB user or guest session (optional)
+func AddSessionQueryParameter(
+	query url.Values,
+	session *Session,
 ) {
-	if withoutKeywords != "" {
-		query.Add(WithoutKeywords, withoutKeywords)
+	if session == nil || session.ID == "" {
+		return
+	}
+	if session.Guest {
+		query.Add(GuestSessionID, session.ID)
+	} else {
+		query.Add(SessionID, session.ID)
+	}
+}
+
+// AddAccountSortByQueryParameter adds the sort_by query parameter for account lists to the HTTP request
+//
+// Parameters:
+//
+// - query: the HTTP request query parameters
+// - sortBy: the sort by value (optional)
+func AddAccountSortByQueryParameter(
+	query url.Values,
+	sortBy AccountSortByEnum,
+) {
+	if sortBy != "" {
+		query.Add(SortBy, string(sortBy))
+	}
+}
+
+// AddAccountListQueryParameters adds the query parameters for account lists to the HTTP request
+//
+// Parameters:
+//
+// - req: the HTTP request
+// - session: the TMDB user session
+// - language: the language code (optional, defaults to "en-US")
+// - page: the page number (optional, defaults to 1)
+// - sortBy: the sort by value (optional)
+func AddAccountListQueryParameters(
+	req *http.Request,
+	session *Session,
+	language string,
+	page int32,
+	sortBy AccountSortByEnum,
+) {
+	q := req.URL.Query()
+	AddSessionQueryParameter(q, session)
+	AddLanguageQueryParameter(q, language)
+	AddPageQueryParameter(q, page)
+	AddAccountSortByQueryParameter(q, sortBy)
+	req.URL.RawQuery = q.Encode()
+}
+
+// AddIncludeImageLanguageQueryParameter adds the include_image_language query parameter to the HTTP request
+//
+// Parameters:
+//
+// - query: the HTTP request query parameters
+// - includeImageLanguage: the list of language codes of the images to include, where "null" includes images without
+// language (optional)
+func AddIncludeImageLanguageQueryParameter(
+	query url.Values,
+	includeImageLanguage []string,
+) {
+	if len(includeImageLanguage) > 0 {
+		query.Add(IncludeImageLanguage, strings.Join(includeImageLanguage, ","))
+	}
+}
+
+// AddIncludeVideoLanguageQueryParameter adds the include_video_language query parameter to the HTTP request
+//
+// Parameters:
+//
+// - query: the HTTP request query parameters
+// - includeVideoLanguage: the list of language codes of the videos to include (optional)
+func AddIncludeVideoLanguageQueryParameter(
+	query url.Values,
+	includeVideoLanguage []string,
+) {
+	if len(includeVideoLanguage) > 0 {
+		query.Add(IncludeVideoLanguage, strings.Join(includeVideoLanguage, ","))
+	}
+}
+
+// AddStartDateQueryParameter adds the start_date query parameter to the HTTP request
+//
+// Parameters:
+//
+// - query: the HTTP request query parameters
+// - startDate: the start date (optional)
+func AddStartDateQueryParameter(
+	query url.Values,
+	startDate Date,
+) {
+	if !startDate.IsZero() {
+		query.Add(StartDate, startDate.String())
+	}
+}
+
+// AddEndDateQueryParameter adds the end_date query parameter to the HTTP request
+//
+// Parameters:
+//
+// - query: the HTTP request query parameters
+// - endDate: the end date (optional)
+func AddEndDateQueryParameter(
+	query url.Values,
+	endDate Date,
+) {
+	if !endDate.IsZero() {
+		query.Add(EndDate, endDate.String())
+	}
+}
+
+// ValidateChangesWindow validates the start and end dates of a changes request
+//
+// Parameters:
+//
+// - startDate: the start date (optional)
+// - endDate: the end date (optional)
+//
+// Returns:
+//
+// - error: if the window is inverted or longer than MaxChangesWindowDays
+func ValidateChangesWindow(startDate, endDate Date) error {
+	// If any of the dates is missing, TMDB defaults to the last 24 hours
+	if startDate.IsZero() || endDate.IsZero() {
+		return nil
+	}
+	if endDate.Before(startDate) || endDate.Time().Sub(startDate.Time()) > MaxChangesWindowDays*24*time.Hour {
+		return ErrInvalidChangesWindow
+	}
+	return nil
+}
+
+// ValidateRating validates that the rating value is between MinRating and MaxRating in steps of RatingStep
+//
+// Parameters:
+//
+// - value: the rating value
+//
+// Returns:
+//
+// - error: if the rating value is not valid
+func ValidateRating(value float32) error {
+	if value < MinRating || value > MaxRating {
+		return ErrInvalidRating
+	}
+	if steps := value / RatingStep; steps != float32(int32(steps)) {
+		return ErrInvalidRating
 	}
+	return nil
+}
+
+// Validate validates the query parameters for discovering movies, reporting all the conflicts at once
+//
+// Returns:
+//
+// - error: the joined errors for each conflict, each wrapping ErrInvalidQueryParameter, or nil if the query parameters
+// are valid
+func (p *DiscoverMoviesQueryParameters) Validate() error {
+	return p.validate(true)
+}
+
+// validate validates the query parameters for discovering movies, reporting all the conflicts at once
+//
+// Parameters:
+//
+// - requireWatchRegion: whether to check the watch providers filters have a watch region, which is false until the
+// default watch region is applied
+//
+// Returns:
+//
+// - error: the joined errors for each conflict, each wrapping ErrInvalidQueryParameter, or nil if the query parameters
+// are valid
+func (p *DiscoverMoviesQueryParameters) validate(requireWatchRegion bool) error {
+	if p == nil {
+		return nil
+	}
+
+	var errs []error
+
+	// Check the page is within the bounds accepted by the TMDB API
+	if p.Page < 0 || p.Page > MaxPage {
+		errs = append(errs, fmt.Errorf(ErrValueOutOfRange, ErrInvalidQueryParameter, Page, 1, MaxPage, p.Page))
+	}
+
+	// Check the bounds of each range
+	errs = append(
+		errs,
+		validateBounds(VoteAverageGTE, p.VoteAverageGTE, MinVoteAverage, MaxVoteAverage),
+		validateBounds(VoteAverageLTE, p.VoteAverageLTE, MinVoteAverage, MaxVoteAverage),
+		validateLowerBound(VoteCountGTE, p.VoteCountGTE, 0),
+		validateLowerBound(VoteCountLTE, p.VoteCountLTE, 0),
+		validateLowerBound(WithRuntimeGTE, p.WithRuntimeGTE, 0),
+		validateLowerBound(WithRuntimeLTE, p.WithRuntimeLTE, 0),
+		validateRange("primary_release_year", p.PrimaryReleaseYearGTE, p.PrimaryReleaseYearLTE),
+		validateRange("vote_average", p.VoteAverageGTE, p.VoteAverageLTE),
+		validateRange("vote_count", p.VoteCountGTE, p.VoteCountLTE),
+		validateRange("with_runtime", p.WithRuntimeGTE, p.WithRuntimeLTE),
+	)
+	if !p.ReleaseDateGTE.IsZero() && !p.ReleaseDateLTE.IsZero() && p.ReleaseDateGTE.After(p.ReleaseDateLTE) {
+		errs = append(
+			errs,
+			fmt.Errorf(ErrInvertedRange, ErrInvalidQueryParameter, "release_date", p.ReleaseDateGTE, p.ReleaseDateLTE),
+		)
+	}
+
+	// Check the parameters that only apply together with another one
+	if p.CertificationCountry == "" {
+		for _, certification := range []struct {
+			key   string
+			value string
+		}{
+			{key: Certification, value: p.Certification},
+			{key: CerificationGTE, value: p.CertificationGTE},
+			{key: CertificationLTE, value: p.CertificationLTE},
+		} {
+			if certification.value != "" {
+				errs = append(
+					errs,
+					fmt.Errorf(ErrMissingDependency, ErrInvalidQueryParameter, certification.key, CertificationCountry),
+				)
+			}
+		}
+	}
+	if requireWatchRegion && p.WatchRegion == "" {
+		if !p.WithWatchProviders.IsEmpty() {
+			errs = append(errs, fmt.Errorf(ErrMissingDependency, ErrInvalidQueryParameter, WithWatchProviders, WatchRegion))
+		}
+		if !p.WithoutWatchProviders.IsEmpty() {
+			errs = append(
+				errs,
+				fmt.Errorf(ErrMissingDependency, ErrInvalidQueryParameter, WithoutWatchProviders, WatchRegion),
+			)
+		}
+		if !p.WithWatchMonetizationTypes.IsEmpty() {
+			errs = append(
+				errs,
+				fmt.Errorf(ErrMissingDependency, ErrInvalidQueryParameter, WithWatchMonetizationTypes, WatchRegion),
+			)
+		}
+	}
+
+	// Check no ID is both included and excluded
+	errs = append(
+		errs,
+		validateFilterOverlap("genres", p.WithGenres, p.WithoutGenres),
+		validateFilterOverlap("companies", p.WithCompanies, p.WithoutCompanies),
+		validateFilterOverlap("keywords", p.WithKeywords, p.WithoutKeywords),
+		validateFilterOverlap("watch_providers", p.WithWatchProviders, p.WithoutWatchProviders),
+	)
+	return errors.Join(errs...)
+}
+
+// validateBounds validates that an optional value is between the given bounds
+//
+// Parameters:
+//
+// - name: the name of the query parameter
+// - value: the optional value
+// - minValue: the minimum value
+// - maxValue: the maximum value
+//
+// Returns:
+//
+// - error: if the value is set and out of bounds
+func validateBounds[T cmp.Ordered](name string, value *T, minValue, maxValue T) error {
+	if value == nil || (*value >= minValue && *value <= maxValue) {
+		return nil
+	}
+	return fmt.Errorf(ErrValueOutOfRange, ErrInvalidQueryParameter, name, minValue, maxValue, *value)
+}
+
+// validateLowerBound validates that an optional value is not lower than the given bound
+//
+// Parameters:
+//
+// - name: the name of the query parameter
+// - value: the optional value
+// - minValue: the minimum value
+//
+// Returns:
+//
+// - error: if the value is set and lower than the bound
+func validateLowerBound[T cmp.Ordered](name string, value *T, minValue T) error {
+	if value == nil || *value >= minValue {
+		return nil
+	}
+	return fmt.Errorf(ErrValueBelowMinimum, ErrInvalidQueryParameter, name, minValue, *value)
+}
+
+// validateRange validates that the lower bound of an optional range is not greater than its upper bound
+//
+// Parameters:
+//
+// - name: the name of the range
+// - gte: the optional lower bound
+// - lte: the optional upper bound
+//
+// Returns:
+//
+// - error: if both bounds are set and the range is inverted
+func validateRange[T cmp.Ordered](name string, gte, lte *T) error {
+	if gte == nil || lte == nil || *gte <= *lte {
+		return nil
+	}
+	return fmt.Errorf(ErrInvertedRange, ErrInvalidQueryParameter, name, *gte, *lte)
+}
+
+// validateFilterOverlap validates that no ID is both included and excluded by the filters
+//
+// Parameters:
+//
+// - name: the name of the filters
+// - with: the inclusion filter
+// - without: the exclusion filter
+//
+// Returns:
+//
+// - error: if some ID is both included and excluded
+func validateFilterOverlap[T comparableID](name string, with, without IDFilter[T]) error {
+	excluded := make(map[T]struct{}, len(without.ids))
+	for _, id := range without.ids {
+		excluded[id] = struct{}{}
+	}
+
+	var overlap []T
+	for _, id := range with.ids {
+		if _, ok := excluded[id]; ok {
+			overlap = append(overlap, id)
+		}
+	}
+	if len(overlap) == 0 {
+		return nil
+	}
+	return fmt.Errorf(ErrConflictingFilter, ErrInvalidQueryParameter, name, joinIDs(overlap, AndSeparator))
+}
+
+// AddChangesQueryParameters adds the query parameters for changes to the HTTP request
+//
+// Parameters:
+//
+// - req: the HTTP request
+// - startDate: the start date (optional)
+// - endDate: the end date (optional)
+// - page: the page number (optional, defaults to 1)
+func AddChangesQueryParameters(
+	req *http.Request,
+	startDate Date,
+	endDate Date,
+	page int32,
+) {
+	q := req.URL.Query()
+	AddStartDateQueryParameter(q, startDate)
+	AddEndDateQueryParameter(q, endDate)
+	AddPageQueryParameter(q, page)
+	req.URL.RawQuery = q.Encode()
 }
 
 // AddMovieListsQueryParameters adds the query parameters for movie lists to the HTTP request
@@ -590,6 +1166,8 @@ func AddWithoutKeywordsQueryParameter(
 // - language: the language code (optional, defaults to "en-US")
 // - page: the page number (optional, defaults to 1)
 // - region: the region code (optional)
+//
+// Deprecated: use AddQueryParameters with MovieListOptions instead.
 func AddMovieListsQueryParameters(
 	req *http.Request,
 	language string,
@@ -615,6 +1193,8 @@ func AddMovieListsQueryParameters(
 // - page: the page number (optional, defaults to 1)
 // - region: the region code (optional)
 // - year: the year (optional)
+//
+// Deprecated: use AddQueryParameters with SearchMoviesOptions instead.
 func AddSearchMoviesQueryParameters(
 	req *http.Request,
 	query string,
@@ -643,6 +1223,8 @@ func AddSearchMoviesQueryParameters(
 // - req: the HTTP request
 // - language: the language code (optional, defaults to "en-US")
 // - page: the page number (optional, defaults to 1)
+//
+// Deprecated: use AddQueryParameters with PageOptions instead.
 func AddSimilarMoviesQueryParameters(
 	req *http.Request,
 	language string,
@@ -654,57 +1236,56 @@ func AddSimilarMoviesQueryParameters(
 	req.URL.RawQuery = q.Encode()
 }
 
+// AddKeywordMoviesQueryParameters adds the query parameters for keyword movies to the HTTP request
+//
+// Parameters:
+//
+// - req: the HTTP request
+// - includeAdult: whether to include adult content
+// - language: the language code (optional, defaults to "en-US")
+// - page: the page number (optional, defaults to 1)
+//
+// Deprecated: use AddQueryParameters with KeywordMoviesOptions instead.
+func AddKeywordMoviesQueryParameters(
+	req *http.Request,
+	includeAdult bool,
+	language string,
+	page int32,
+) {
+	q := req.URL.Query()
+	AddIncludeAdultQueryParameter(q, includeAdult)
+	AddLanguageQueryParameter(q, language)
+	AddPageQueryParameter(q, page)
+	req.URL.RawQuery = q.Encode()
+}
+
 // AddGenreMovieListQueryParameters adds the query parameters for genre movie lists to the HTTP request
 //
 // Parameters:
 //
 // - req: the HTTP request
-// - queryParameters: the query parameters for discovering movies
+// - queryParameters: the query parameters for discovering movies (optional)
+//
+// Returns:
+//
+// - error: if the query parameters could not be encoded
 func AddGenreMovieListQueryParameters(
 	req *http.Request,
 	queryParameters *DiscoverMoviesQueryParameters,
-) {
-	// If query parameters are nil, return
-	if queryParameters == nil {
-		return
-	}
+) error {
+	return AddQueryParameters(req, queryParameters)
+}
 
+// mergeQueryParameters adds the query parameters to the HTTP request, keeping those already set, such as the API key
+//
+// Parameters:
+//
+// - req: the HTTP request
+// - query: the query parameters
+func mergeQueryParameters(req *http.Request, query url.Values) {
 	q := req.URL.Query()
-	AddCertificationQueryParameter(q, queryParameters.Certification)
-	AddCertificationCountryQueryParameter(q, queryParameters.CertificationCountry)
-	AddCertificationGTEQueryParameter(q, queryParameters.CertificationGTE)
-	AddCertificationLTEQueryParameter(q, queryParameters.CertificationLTE)
-	AddIncludeAdultQueryParameter(q, queryParameters.IncludeAdult)
-	AddIncludeVideoQueryParameter(q, queryParameters.IncludeVideo)
-	AddLanguageQueryParameter(q, queryParameters.Language)
-	AddPageQueryParameter(q, queryParameters.Page)
-	AddPrimaryReleaseYearQueryParameter(q, queryParameters.PrimaryReleaseYear)
-	AddPrimaryReleaseYearGTEQueryParameter(q, queryParameters.PrimaryReleaseYearGTE)
-	AddPrimaryReleaseYearLTEQueryParameter(q, queryParameters.PrimaryReleaseYearLTE)
-	AddRegionQueryParameter(q, queryParameters.Region)
-	AddReleaseDateGTEQueryParameter(q, queryParameters.ReleaseDateGTE)
-	AddReleaseDateLTEQueryParameter(q, queryParameters.ReleaseDateLTE)
-	AddSortByQueryParameter(q, queryParameters.SortBy)
-	AddVoteAverageGTEQueryParameter(q, queryParameters.VoteAverageGTE)
-	AddVoteAverageLTEQueryParameter(q, queryParameters.VoteAverageLTE)
-	AddVoteCountGTEQueryParameter(q, queryParameters.VoteCountGTE)
-	AddVoteCountLTEQueryParameter(q, queryParameters.VoteCountLTE)
-	AddWithGenresQueryParameter(q, queryParameters.WithGenres)
-	AddWithCompaniesQueryParameter(q, queryParameters.WithCompanies)
-	AddWithKeywordsQueryParameter(q, queryParameters.WithKeywords)
-	AddWithCastQueryParameter(q, queryParameters.WithCast)
-	AddWithCrewQueryParameter(q, queryParameters.WithCrew)
-	AddWithPeopleQueryParameter(q, queryParameters.WithPeople)
-	AddWithOriginCountryQueryParameter(q, queryParameters.WithOriginCountry)
-	AddWithOriginalLanguageQueryParameter(q, queryParameters.WithOriginalLanguage)
-	AddWatchRegionQueryParameter(q, queryParameters.WatchRegion)
-	AddWithRuntimeGTEQueryParameter(q, queryParameters.WithRuntimeGTE)
-	AddWithRuntimeLTEQueryParameter(q, queryParameters.WithRuntimeLTE)
-	AddWithWatchMonetizationTypesQueryParameter(q, queryParameters.WithWatchMonetizationTypes)
-	AddWithWatchProvidersQueryParameter(q, queryParameters.WithWatchProviders)
-	AddWithoutCompaniesQueryParameter(q, queryParameters.WithoutCompanies)
-	AddWithoutGenresQueryParameter(q, queryParameters.WithoutGenres)
-	AddWithoutKeywordsQueryParameter(q, queryParameters.WithoutKeywords)
-	AddYearQueryParameter(q, queryParameters.Year)
+	for key, values := range query {
+		q[key] = values
+	}
 	req.URL.RawQuery = q.Encode()
 }
@@ -0,0 +1,154 @@
+package gotmdbapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// TestValidateChangesWindow tests the validation of the changes window dates
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestValidateChangesWindow(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		startDate Date
+		endDate   Date
+		wantErr   bool
+	}{
+		{name: "empty window"},
+		{name: "only start date", startDate: NewDate(2024, time.January, 1)},
+		{name: "fourteen days", startDate: NewDate(2024, time.January, 1), endDate: NewDate(2024, time.January, 15)},
+		{
+			name:      "fifteen days",
+			startDate: NewDate(2024, time.January, 1),
+			endDate:   NewDate(2024, time.January, 16),
+			wantErr:   true,
+		},
+		{
+			name:      "inverted window",
+			startDate: NewDate(2024, time.January, 10),
+			endDate:   NewDate(2024, time.January, 1),
+			wantErr:   true,
+		},
+	} {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				err := ValidateChangesWindow(tc.startDate, tc.endDate)
+				if tc.wantErr && !errors.Is(err, ErrInvalidChangesWindow) {
+					t.Fatalf("ValidateChangesWindow returned %v, expected %v", err, ErrInvalidChangesWindow)
+				}
+				if !tc.wantErr && err != nil {
+					t.Fatalf("ValidateChangesWindow returned unexpected error: %v", err)
+				}
+			},
+		)
+	}
+}
+
+// TestValidateRating tests the validation of the rating values
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestValidateRating(t *testing.T) {
+	for _, value := range []float32{0.5, 1, 7.5, 10} {
+		if err := ValidateRating(value); err != nil {
+			t.Fatalf("ValidateRating(%v) returned unexpected error: %v", value, err)
+		}
+	}
+	for _, value := range []float32{0, 0.25, 7.3, 10.5, -1} {
+		if err := ValidateRating(value); !errors.Is(err, ErrInvalidRating) {
+			t.Fatalf("ValidateRating(%v) returned %v, expected %v", value, err, ErrInvalidRating)
+		}
+	}
+}
+
+// TestAddGenreMovieListQueryParametersFilters tests the encoding of the AND/OR list filters
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestAddGenreMovieListQueryParametersFilters(t *testing.T) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, DiscoverMoviesURL, http.NoBody)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	err = AddGenreMovieListQueryParameters(
+		req, &DiscoverMoviesQueryParameters{
+			WithGenres:    AnyOf[GenreID](28, 12),
+			WithCast:      AllOf[PersonID](287, 819),
+			WithoutGenres: AnyOf[GenreID](27),
+		},
+	)
+	if err != nil {
+		t.Fatalf("AddGenreMovieListQueryParameters returned unexpected error: %v", err)
+	}
+
+	// Check the filters are encoded once, with the expected separators
+	q := req.URL.Query()
+	for key, want := range map[string]string{
+		WithGenres:    "28|12",
+		WithCast:      "287,819",
+		WithoutGenres: "27",
+	} {
+		if got := q.Get(key); got != want {
+			t.Fatalf("Query parameter %s is %q, expected %q", key, got, want)
+		}
+	}
+}
+
+// TestSearchMoviesOptionsEncoding tests the encoding of the search options, where the unset fields are not sent
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestSearchMoviesOptionsEncoding(t *testing.T) {
+	query := url.Values{}
+	err := EncodeQueryParameters(
+		query, &SearchMoviesOptions{
+			Language:           "en-US",
+			PrimaryReleaseYear: 1999,
+			Year:               2000,
+		},
+	)
+	if err != nil {
+		t.Fatalf("EncodeQueryParameters returned unexpected error: %v", err)
+	}
+
+	want := "language=en-US&primary_release_year=1999&year=2000"
+	if got := query.Encode(); got != want {
+		t.Fatalf("EncodeQueryParameters encoded %q, expected %q", got, want)
+	}
+}
+
+// TestDeprecatedQueryParameterHelpers tests that the deprecated helpers of each query parameter encode it as the query
+// parameters for discovering movies do
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestDeprecatedQueryParameterHelpers(t *testing.T) {
+	query := url.Values{}
+	// nolint:staticcheck
+	AddWithGenresQueryParameter(query, AnyOf[GenreID](28, 12))
+	// nolint:staticcheck
+	AddVoteAverageGTEQueryParameter(query, ptr(float32(7.5)))
+	// nolint:staticcheck
+	AddReleaseDateLTEQueryParameter(query, Date{})
+	// nolint:staticcheck
+	AddWithReleaseTypeQueryParameter(query, []ReleaseTypeEnum{ReleaseTypeTheatricalLimited, ReleaseTypeTheatrical})
+	// nolint:staticcheck
+	AddWithWatchMonetizationTypesQueryParameter(query, nil)
+
+	want := "vote_average.gte=7.5&with_genres=28%7C12&with_release_type=2%7C3"
+	if got := query.Encode(); got != want {
+		t.Fatalf("Deprecated helpers encoded %q, expected %q", got, want)
+	}
+}
@@ -0,0 +1,26 @@
+module github.com/ralvarezdev/go-tmdb-api/tmdbotel
+
+go 1.25.4
+
+// The core release is tagged before this module, since the instrumentation relies on its request hooks
+require (
+	github.com/ralvarezdev/go-tmdb-api v0.1.0
+	go.opentelemetry.io/otel v1.46.0
+	go.opentelemetry.io/otel/metric v1.46.0
+	go.opentelemetry.io/otel/sdk v1.46.0
+	go.opentelemetry.io/otel/sdk/metric v1.46.0
+	go.opentelemetry.io/otel/trace v1.46.0
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/go-logr/logr v1.4.4 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.2.1 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+)
+
+// Build against the local checkout during development, since replace directives are ignored by the dependents,
+// which resolve the required version instead
+replace github.com/ralvarezdev/go-tmdb-api => ../
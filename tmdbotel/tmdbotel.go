@@ -0,0 +1,264 @@
+// Package tmdbotel instruments the TMDB API client with OpenTelemetry traces and metrics.
+//
+// It is a separate module, so the TMDB API client does not depend on OpenTelemetry.
+package tmdbotel
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	gotmdbapi "github.com/ralvarezdev/go-tmdb-api"
+)
+
+const (
+	// ScopeName is the instrumentation scope name of the tracer and meter
+	ScopeName = "github.com/ralvarezdev/go-tmdb-api/tmdbotel"
+
+	// SpanNamePrefix is the prefix of the span names, followed by the endpoint, such as tmdb.GetMovieDetails
+	SpanNamePrefix = "tmdb."
+
+	// RequestsMetric is the name of the request count metric
+	RequestsMetric = "tmdb.client.requests"
+
+	// RequestDurationMetric is the name of the request latency histogram, in seconds
+	RequestDurationMetric = "tmdb.client.request.duration"
+
+	// RateLimitRemainingMetric is the name of the rate limit remaining gauge
+	RateLimitRemainingMetric = "tmdb.client.rate_limit.remaining"
+
+	// EndpointKey is the attribute of the client method that made the request, such as GetMovieDetails
+	EndpointKey = attribute.Key("tmdb.endpoint")
+
+	// MovieIDKey is the attribute of the movie ID of the request
+	MovieIDKey = attribute.Key("tmdb.movie.id")
+
+	// PageKey is the attribute of the requested page
+	PageKey = attribute.Key("tmdb.page")
+
+	// TMDBStatusCodeKey is the attribute of the TMDB API status code of the unsuccessful responses
+	TMDBStatusCodeKey = attribute.Key("tmdb.status_code")
+
+	// AttemptsKey is the attribute of the number of requests sent to the TMDB API, including the retries
+	AttemptsKey = attribute.Key("tmdb.attempts")
+
+	// CacheHitKey is the attribute of whether the response was served from a cache
+	CacheHitKey = attribute.Key("tmdb.cache_hit")
+
+	// HTTPMethodKey is the attribute of the HTTP request method
+	HTTPMethodKey = attribute.Key("http.request.method")
+
+	// HTTPStatusCodeKey is the attribute of the HTTP response status code
+	HTTPStatusCodeKey = attribute.Key("http.response.status_code")
+)
+
+type (
+	// Option configures the instrumentation
+	Option func(cfg *config)
+
+	// config represents the instrumentation configuration
+	config struct {
+		tracerProvider trace.TracerProvider
+		meterProvider  metric.MeterProvider
+	}
+
+	// instrumentation records the spans and metrics of the TMDB API requests
+	instrumentation struct {
+		tracer             trace.Tracer
+		requests           metric.Int64Counter
+		duration           metric.Float64Histogram
+		rateLimitRemaining metric.Int64Gauge
+	}
+)
+
+// WithTracerProvider sets the tracer provider
+//
+// Parameters:
+//
+// - provider: the tracer provider (optional, defaults to the global tracer provider)
+//
+// Returns:
+//
+// - Option: the option
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(cfg *config) {
+		cfg.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the meter provider
+//
+// Parameters:
+//
+// - provider: the meter provider (optional, defaults to the global meter provider)
+//
+// Returns:
+//
+// - Option: the option
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(cfg *config) {
+		cfg.meterProvider = provider
+	}
+}
+
+// Instrument adds the hooks that create a span per TMDB API call, named after the endpoint, and record the request
+// count, latency and rate limit remaining metrics
+//
+// The span is set in the request context, so it is propagated to the middlewares and the HTTP client, and the calls
+// made on behalf of the request, such as those of the language fallback chain, are its children.
+//
+// Parameters:
+//
+// - client: the TMDB API client
+// - opts: the options
+//
+// Returns:
+//
+// - error: if the metric instruments could not be created
+func Instrument(client *gotmdbapi.Client, opts ...Option) error {
+	cfg := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	// Create the metric instruments
+	meter := cfg.meterProvider.Meter(ScopeName)
+	requests, err := meter.Int64Counter(
+		RequestsMetric,
+		metric.WithDescription("Number of TMDB API requests"),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return err
+	}
+	duration, err := meter.Float64Histogram(
+		RequestDurationMetric,
+		metric.WithDescription("Duration of the TMDB API requests"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+	rateLimitRemaining, err := meter.Int64Gauge(
+		RateLimitRemainingMetric,
+		metric.WithDescription("Number of TMDB API requests remaining in the rate limit window"),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return err
+	}
+
+	i := &instrumentation{
+		tracer:             cfg.tracerProvider.Tracer(ScopeName),
+		requests:           requests,
+		duration:           duration,
+		rateLimitRemaining: rateLimitRemaining,
+	}
+	client.OnBeforeRequest(i.startSpan)
+	client.OnAfterResponse(i.endSpan)
+	return nil
+}
+
+// startSpan starts the span of a request
+//
+// Parameters:
+//
+// - req: the HTTP request
+//
+// Returns:
+//
+// - context.Context: the request context with the span, so the nested requests are its children
+func (i *instrumentation) startSpan(req *http.Request) context.Context {
+	attrs := []attribute.KeyValue{
+		EndpointKey.String(gotmdbapi.EndpointFromContext(req.Context())),
+		HTTPMethodKey.String(req.Method),
+	}
+	if movieID, ok := movieIDFromPath(req.URL.Path); ok {
+		attrs = append(attrs, MovieIDKey.Int64(movieID))
+	}
+	if page, err := strconv.ParseInt(req.URL.Query().Get(gotmdbapi.Page), 10, 32); err == nil {
+		attrs = append(attrs, PageKey.Int64(page))
+	}
+
+	ctx, _ := i.tracer.Start(
+		req.Context(),
+		SpanNamePrefix+gotmdbapi.EndpointFromContext(req.Context()),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	return ctx
+}
+
+// endSpan ends the span of a request and records its metrics
+//
+// Parameters:
+//
+// - req: the HTTP request, whose context carries the span started by startSpan
+// - meta: the response metadata
+// - err: the error of the request (optional)
+func (i *instrumentation) endSpan(req *http.Request, meta *gotmdbapi.ResponseMeta, err error) {
+	span := trace.SpanFromContext(req.Context())
+	span.SetAttributes(
+		HTTPStatusCodeKey.Int(meta.StatusCode),
+		AttemptsKey.Int(meta.Attempts),
+		CacheHitKey.Bool(meta.CacheHit),
+	)
+	if meta.TMDBStatusCode != 0 {
+		span.SetAttributes(TMDBStatusCodeKey.Int64(int64(meta.TMDBStatusCode)))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+
+	// Record the metrics in the context of the span, so they can be linked to the trace through exemplars
+	ctx := context.WithoutCancel(req.Context())
+	attrs := metric.WithAttributes(
+		EndpointKey.String(meta.Endpoint),
+		HTTPStatusCodeKey.Int(meta.StatusCode),
+	)
+	i.requests.Add(ctx, 1, attrs)
+	i.duration.Record(ctx, meta.Latency.Seconds(), attrs)
+
+	if meta.RateLimitRemaining != nil {
+		i.rateLimitRemaining.Record(
+			ctx,
+			int64(*meta.RateLimitRemaining),
+			metric.WithAttributes(EndpointKey.String(meta.Endpoint)),
+		)
+	}
+}
+
+// movieIDFromPath returns the movie ID of a TMDB API path, such as /3/movie/550/credits
+//
+// Parameters:
+//
+// - path: the URL path
+//
+// Returns:
+//
+// - int64: the movie ID
+// - bool: true if the path has a movie ID
+func movieIDFromPath(path string) (int64, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for j := 0; j+1 < len(segments); j++ {
+		if segments[j] != "movie" {
+			continue
+		}
+		if movieID, err := strconv.ParseInt(segments[j+1], 10, 32); err == nil {
+			return movieID, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,266 @@
+package tmdbotel
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	gotmdbapi "github.com/ralvarezdev/go-tmdb-api"
+)
+
+// newInstrumentedClient creates a TMDB API client instrumented with in-memory exporters, whose requests are answered by
+// the given stub
+//
+// Parameters:
+//
+// - t: the testing.T instance
+// - stub: the stub of the TMDB API
+//
+// Returns:
+//
+// - *gotmdbapi.Client: the instrumented client
+// - *tracetest.SpanRecorder: the recorder of the spans
+// - *sdkmetric.ManualReader: the reader of the metrics
+func newInstrumentedClient(t *testing.T, stub gotmdbapi.DoerFunc) (
+	*gotmdbapi.Client,
+	*tracetest.SpanRecorder,
+	*sdkmetric.ManualReader,
+) {
+	client, err := gotmdbapi.NewClient("api-key")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client = client.WithHTTPClient(stub)
+
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	if err = Instrument(
+		client,
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	); err != nil {
+		t.Fatalf("Failed to instrument client: %v", err)
+	}
+	return client, recorder, reader
+}
+
+// spanAttributes returns the attributes of a span by key
+//
+// Parameters:
+//
+// - attrs: the span attributes
+//
+// Returns:
+//
+// - map[attribute.Key]attribute.Value: the attributes by key
+func spanAttributes(attrs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	byKey := make(map[attribute.Key]attribute.Value, len(attrs))
+	for _, attr := range attrs {
+		byKey[attr.Key] = attr.Value
+	}
+	return byKey
+}
+
+// TestInstrumentSpans tests that a span is created per call, named after the endpoint, and propagated to the HTTP
+// client
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestInstrumentSpans(t *testing.T) {
+	var propagated trace.SpanContext
+	client, recorder, _ := newInstrumentedClient(
+		t, func(req *http.Request) (*http.Response, error) {
+			propagated = trace.SpanContextFromContext(req.Context())
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{http.CanonicalHeaderKey(gotmdbapi.RateLimitRemainingHeader): {"39"}},
+				Body:       io.NopCloser(strings.NewReader(`{"page":2,"results":[]}`)),
+				Request:    req,
+			}, nil
+		},
+	)
+
+	if _, _, err := client.SimilarMoviesWithOptions(
+		context.Background(),
+		550,
+		&gotmdbapi.PageOptions{Page: 2},
+	); err != nil {
+		t.Fatalf("SimilarMoviesWithOptions() failed: %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("%d spans were recorded, expected 1", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "tmdb.SimilarMovies" {
+		t.Errorf("span name is %q, expected %q", span.Name(), "tmdb.SimilarMovies")
+	}
+	if span.SpanKind() != trace.SpanKindClient {
+		t.Errorf("span kind is %v, expected %v", span.SpanKind(), trace.SpanKindClient)
+	}
+	if propagated.SpanID() != span.SpanContext().SpanID() {
+		t.Error("span was not propagated to the HTTP client")
+	}
+
+	attrs := spanAttributes(span.Attributes())
+	for key, expected := range map[attribute.Key]attribute.Value{
+		EndpointKey:       attribute.StringValue("SimilarMovies"),
+		MovieIDKey:        attribute.Int64Value(550),
+		PageKey:           attribute.Int64Value(2),
+		HTTPStatusCodeKey: attribute.IntValue(http.StatusOK),
+		AttemptsKey:       attribute.IntValue(1),
+		CacheHitKey:       attribute.BoolValue(false),
+	} {
+		if got := attrs[key]; got != expected {
+			t.Errorf("attribute %s is %v, expected %v", key, got.Emit(), expected.Emit())
+		}
+	}
+}
+
+// TestInstrumentNestedSpans tests that the calls made on behalf of a request, such as those of the language fallback
+// chain, are children of its span
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestInstrumentNestedSpans(t *testing.T) {
+	client, recorder, _ := newInstrumentedClient(
+		t, func(req *http.Request) (*http.Response, error) {
+			body := `{"id":550,"title":"Fight Club"}`
+			if strings.HasSuffix(req.URL.Path, "/translations") {
+				body = `{"id":550,"translations":[]}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Request:    req,
+			}, nil
+		},
+	)
+
+	if _, _, err := client.WithFallbackLanguages("es").GetMovieDetails(context.Background(), 550, ""); err != nil {
+		t.Fatalf("GetMovieDetails() failed: %v", err)
+	}
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	details, translations := spans["tmdb.GetMovieDetails"], spans["tmdb.GetMovieTranslations"]
+	if details == nil || translations == nil {
+		t.Fatalf("spans are %v, expected the movie details and translations spans", spans)
+	}
+	if translations.Parent().SpanID() != details.SpanContext().SpanID() {
+		t.Error("translations span is not a child of the movie details span")
+	}
+}
+
+// TestInstrumentErrorSpan tests that the span of a failed call records the error and the TMDB API status code
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestInstrumentErrorSpan(t *testing.T) {
+	client, recorder, _ := newInstrumentedClient(
+		t, func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body: io.NopCloser(
+					strings.NewReader(`{"success":false,"status_code":34,"status_message":"Not found"}`),
+				),
+				Request: req,
+			}, nil
+		},
+	)
+
+	if _, _, err := client.GetMovieDetails(context.Background(), 1, ""); err == nil {
+		t.Fatal("GetMovieDetails() succeeded, expected an error")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("%d spans were recorded, expected 1", len(spans))
+	}
+	span := spans[0]
+	if span.Status().Code != codes.Error {
+		t.Errorf("span status is %v, expected %v", span.Status().Code, codes.Error)
+	}
+	attrs := spanAttributes(span.Attributes())
+	if got := attrs[TMDBStatusCodeKey]; got != attribute.Int64Value(34) {
+		t.Errorf("attribute %s is %v, expected 34", TMDBStatusCodeKey, got.Emit())
+	}
+	if got := attrs[HTTPStatusCodeKey]; got != attribute.IntValue(http.StatusNotFound) {
+		t.Errorf("attribute %s is %v, expected %d", HTTPStatusCodeKey, got.Emit(), http.StatusNotFound)
+	}
+}
+
+// TestInstrumentMetrics tests that the request count, latency and rate limit remaining metrics are recorded
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestInstrumentMetrics(t *testing.T) {
+	client, _, reader := newInstrumentedClient(
+		t, func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{http.CanonicalHeaderKey(gotmdbapi.RateLimitRemainingHeader): {"39"}},
+				Body:       io.NopCloser(strings.NewReader(`{"id":550,"title":"Fight Club"}`)),
+				Request:    req,
+			}, nil
+		},
+	)
+
+	for range 2 {
+		if _, _, err := client.GetMovieDetails(context.Background(), 550, ""); err != nil {
+			t.Fatalf("GetMovieDetails() failed: %v", err)
+		}
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Failed to collect metrics: %v", err)
+	}
+	metrics := make(map[string]metricdata.Aggregation)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+
+	requests, ok := metrics[RequestsMetric].(metricdata.Sum[int64])
+	if !ok || len(requests.DataPoints) != 1 || requests.DataPoints[0].Value != 2 {
+		t.Errorf("%s is %+v, expected a single data point of 2", RequestsMetric, metrics[RequestsMetric])
+	} else {
+		endpoint, _ := requests.DataPoints[0].Attributes.Value(EndpointKey)
+		if endpoint.AsString() != "GetMovieDetails" {
+			t.Errorf("%s endpoint is %q, expected %q", RequestsMetric, endpoint.AsString(), "GetMovieDetails")
+		}
+	}
+
+	duration, ok := metrics[RequestDurationMetric].(metricdata.Histogram[float64])
+	if !ok || len(duration.DataPoints) != 1 || duration.DataPoints[0].Count != 2 {
+		t.Errorf(
+			"%s is %+v, expected a single data point of 2 requests",
+			RequestDurationMetric,
+			metrics[RequestDurationMetric],
+		)
+	}
+
+	remaining, ok := metrics[RateLimitRemainingMetric].(metricdata.Gauge[int64])
+	if !ok || len(remaining.DataPoints) != 1 || remaining.DataPoints[0].Value != 39 {
+		t.Errorf("%s is %+v, expected 39", RateLimitRemainingMetric, metrics[RateLimitRemainingMetric])
+	}
+}
@@ -1,17 +1,43 @@
 package gotmdbapi
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
 )
 
 type (
 	// Client is the TMDB API client
 	Client struct {
-		apiKey string
+		apiKey             string
+		credentials        CredentialProvider
+		authMode           AuthMode
+		defaults           Defaults
+		httpClient         Doer
+		middlewares        []Middleware
+		beforeRequestHooks []BeforeRequestHook
+		afterResponseHooks []AfterResponseHook
+		logger             *slog.Logger
+		loggerOptions      LoggerOptions
+	}
+
+	// successResponse is implemented by the TMDB API responses that report whether the request was successful
+	successResponse interface {
+		successful() (bool, int32, string)
+	}
+
+	// Session is a TMDB user or guest session used to authenticate per-user requests
+	Session struct {
+		ID        string
+		Guest     bool
+		ExpiresAt string
 	}
 )
 
@@ -19,7 +45,8 @@ type (
 //
 // Parameters:
 //
-// - apiKey: the TMDB API key
+// - apiKey: the TMDB API v4 read access token or v3 API key, sent as detected by DetectAuthMode unless the client is
+// derived with WithAuthMode
 //
 // Returns:
 //
@@ -35,69 +62,367 @@ func NewClient(apiKey string) (*Client, error) {
 	}, nil
 }
 
-// addAuthorizationToRequest adds the Authorization header to the HTTP request
+// NewSession creates a new TMDB user session
+//
+// Parameters:
+//
+// - sessionID: the session ID
+//
+// Returns:
+//
+// - *Session: the TMDB user session
+func NewSession(sessionID string) *Session {
+	return &Session{
+		ID: sessionID,
+	}
+}
+
+// NewGuestSession creates a new TMDB guest session
+//
+// Parameters:
+//
+// - guestSessionID: the guest session ID
+// - expiresAt: the expiration date of the guest session (optional)
+//
+// Returns:
+//
+// - *Session: the TMDB guest session
+func NewGuestSession(guestSessionID string, expiresAt string) *Session {
+	return &Session{
+		ID:        guestSessionID,
+		Guest:     true,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// addAuthorizationToRequest authenticates the HTTP request with the API key of the credential provider, either in the
+// Authorization header or the api_key query parameter, following the authentication mode
+//
+// Parameters:
+//
+// - req: the HTTP request
+//
+// Returns:
+//
+// - *http.Request: the HTTP request, whose context records the API key used
+// - error: if the credential provider has no API key available
+func (c Client) addAuthorizationToRequest(req *http.Request) (*http.Request, error) {
+	apiKey, err := c.credentialProvider().Credential(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(context.WithValue(req.Context(), credentialContextKey{}, apiKey))
+	setCredential(req, apiKey, c.resolveAuthMode(apiKey))
+	return req, nil
+}
+
+// buildRequest creates a new HTTP request to the TMDB API, which is authenticated once it is sent
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - endpoint: the endpoint name, used by the middlewares, hooks and logs
+// - method: the HTTP method
+// - apiURL: the TMDB API URL
+// - body: the value to be encoded as the JSON request body (optional)
+//
+// Returns:
+//
+// - *http.Request: the HTTP request
+// - error: if there was an error building the request
+func buildRequest(
+	ctx context.Context,
+	endpoint string,
+	method string,
+	apiURL string,
+	body any,
+) (*http.Request, error) {
+	// Encode the request body, if any
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		encodedBody, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf(ErrBuildingRequest, err)
+		}
+		reqBody = bytes.NewReader(encodedBody)
+	}
+
+	// Record the endpoint name, used by the middlewares and hooks
+	ctx = context.WithValue(ctx, endpointContextKey{}, endpoint)
+
+	req, err := http.NewRequestWithContext(ctx, method, apiURL, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf(ErrBuildingRequest, err)
+	}
+
+	// Set the Content-Type header for JSON request bodies
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json;charset=utf-8")
+	}
+	return req, nil
+}
+
+// doRequest makes the HTTP request to the TMDB API through the middlewares and hooks, and parses the JSON response
+//
+// Parameters:
+//
+// - req: the HTTP request
+// - parsedResp: the pointer to the value where the response will be parsed into
+//
+// Returns:
+//
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error making the request or parsing the response
+func (c Client) doRequest(req *http.Request, parsedResp any) (int, error) {
+	_, statusCode, err := c.sendRequest(req, parsedResp, c.addAuthorizationToRequest)
+	return statusCode, err
+}
+
+// sendRequest makes the HTTP request to the TMDB API through the middlewares and hooks, and parses the JSON response
 //
 // Parameters:
 //
 // - req: the HTTP request
-func (c Client) addAuthorizationToRequest(req *http.Request) {
-	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+// - parsedResp: the pointer to the value where the response will be parsed into
+// - authorize: the function that authenticates the request, called just before it is sent, so the credentials are
+// only picked for the requests that are sent and reported
+//
+// Returns:
+//
+// - *http.Request: the HTTP request as sent, with the context returned by the before hooks, so the follow-up requests,
+// such as the ones of the language fallback, can be made within it
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error making the request or parsing the response
+func (c Client) sendRequest(
+	req *http.Request,
+	parsedResp any,
+	authorize func(req *http.Request) (*http.Request, error),
+) (sentReq *http.Request, statusCode int, err error) {
+	if req, err = authorize(req); err != nil {
+		return nil, 0, err
+	}
+
+	meta := &ResponseMeta{
+		Endpoint: EndpointFromContext(req.Context()),
+		URL:      RedactURL(req.URL),
+	}
+	for _, hook := range c.beforeRequestHooks {
+		if ctx := hook(req); ctx != nil {
+			req = req.WithContext(ctx)
+		}
+	}
+
+	// Capture the metadata, log the request and call the after hooks with the final result, once the response is parsed
+	var body []byte
+	var attempts atomic.Int32
+	start := time.Now()
+	defer func() {
+		meta.Latency = time.Since(start)
+		meta.Attempts = int(attempts.Load())
+		captureResponseMeta(req.Context(), meta)
+		c.reportCredential(req, meta, err)
+		c.logRequest(req, meta, err, body)
+		for _, hook := range c.afterResponseHooks {
+			hook(req, meta, err)
+		}
+	}()
+
+	// Make the HTTP request
+	resp, err := c.doer(&attempts).Do(req)
+	if err != nil {
+		redactError(err)
+		return req, 0, fmt.Errorf(ErrAnErrOcurredDuringRequest, err)
+	}
+	defer resp.Body.Close()
+	meta.StatusCode = resp.StatusCode
+	meta.setHeader(resp.Header)
+	meta.CacheHit = resp.Header.Get(CacheHeader) == "1"
+	if resp.Request != nil {
+		meta.URL = RedactURL(resp.Request.URL)
+	}
+
+	// Read the response body
+	if body, err = io.ReadAll(resp.Body); err != nil {
+		return req, resp.StatusCode, fmt.Errorf(ErrAnErrOcurredDuringRequest, err)
+	}
+
+	// Check for non-2xx status codes, since write endpoints answer with 201 Created
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		var status StatusResponse
+		if json.Unmarshal(body, &status) == nil {
+			meta.TMDBStatusCode = status.StatusCode
+		}
+		return req, resp.StatusCode, fmt.Errorf(ErrRequestFailed, resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	if parseErr := json.Unmarshal(body, parsedResp); parseErr != nil {
+		return req, resp.StatusCode, ErrResponseParsing
+	}
+
+	// Check if the TMDB API reported the request as unsuccessful
+	if checker, ok := parsedResp.(successResponse); ok {
+		if success, tmdbStatusCode, statusMessage := checker.successful(); !success {
+			meta.TMDBStatusCode = tmdbStatusCode
+			return req, resp.StatusCode, fmt.Errorf(ErrUnsuccessfulResponse, tmdbStatusCode, statusMessage)
+		}
+	}
+	return req, resp.StatusCode, nil
+}
+
+// validateUserSession validates that the session is a non-nil user session
+//
+// Parameters:
+//
+// - session: the TMDB session
+//
+// Returns:
+//
+// - error: if the session is nil or a guest session
+func validateUserSession(session *Session) error {
+	if err := validateSession(session); err != nil {
+		return err
+	}
+	if session.Guest {
+		return ErrGuestSessionNotAllowed
+	}
+	return nil
+}
+
+// validateSession validates that the session is non-nil and has an ID
+//
+// Parameters:
+//
+// - session: the TMDB user or guest session
+//
+// Returns:
+//
+// - error: if the session is nil or has an empty ID
+func validateSession(session *Session) error {
+	if session == nil || session.ID == "" {
+		return ErrNilSession
+	}
+	return nil
+}
+
+// validateMediaType validates that the media type is either a movie or a TV show
+//
+// Parameters:
+//
+// - mediaType: the media type
+//
+// Returns:
+//
+// - error: if the media type is not valid
+func validateMediaType(mediaType MediaTypeEnum) error {
+	if mediaType != MediaTypeMovie && mediaType != MediaTypeTV {
+		return ErrInvalidMediaType
+	}
+	return nil
+}
+
+// GetMoviesNowPlayingWithOptions fetches the list of now playing movies
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - opts: the language, page and region of the list (optional)
+//
+// Returns:
+//
+// - (*DateMovieListResponse): the response containing the list of now playing movies
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the movies
+func (c Client) GetMoviesNowPlayingWithOptions(
+	ctx context.Context,
+	opts *MovieListOptions,
+) (parsedResp *DateMovieListResponse, statusCode int, err error) {
+	// Create the HTTP request
+	req, err := buildRequest(ctx, "GetMoviesNowPlaying", http.MethodGet, GetNowPlayingMoviesURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Add query parameters
+	if err = AddQueryParameters(req, opts); err != nil {
+		return nil, 0, fmt.Errorf(ErrBuildingRequest, err)
+	}
+	c.addDefaultQueryParameters(req, Language, Region)
+
+	// Make the HTTP request and parse the response
+	parsedResp = &DateMovieListResponse{}
+	if req, statusCode, err = c.sendRequest(req, parsedResp, c.addAuthorizationToRequest); err != nil {
+		return nil, statusCode, err
+	}
+
+	// Fill the empty localized fields from the language fallback chain
+	c.applyMoviesFallback(req, parsedResp.Results)
+	return parsedResp, statusCode, nil
 }
 
-// GetMoviesNowPlaying fetches the list of movies that are now playing in theaters
+// GetMoviesNowPlaying fetches the list of now playing movies
 //
 // Parameters:
 //
 // - ctx: the context of the request
-// - id: the movie ID
 // - language: the language code (optional, defaults to "en-US")
 // - page: the page number (optional, defaults to 1)
 // - region: the region code (optional)
 //
 // Returns:
 //
-// - (*DataMovieListResponse): the response containing the list of movies
-// - int: the HTTP status code
+// - (*DateMovieListResponse): the response containing the list of now playing movies
+// - int: the HTTP status code, or 0 if no response was received
 // - error: if there was an error fetching the movies
+//
+// Deprecated: use GetMoviesNowPlayingWithOptions instead.
 func (c Client) GetMoviesNowPlaying(
 	ctx context.Context,
 	language string,
 	page int32,
 	region string,
 ) (parsedResp *DateMovieListResponse, statusCode int, err error) {
+	return c.GetMoviesNowPlayingWithOptions(ctx, &MovieListOptions{Language: language, Page: page, Region: region})
+}
+
+// GetMoviesPopularWithOptions fetches the list of popular movies
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - opts: the language, page and region of the list (optional)
+//
+// Returns:
+//
+// - (*MovieListResponse): the response containing the list of popular movies
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the movies
+func (c Client) GetMoviesPopularWithOptions(
+	ctx context.Context,
+	opts *MovieListOptions,
+) (parsedResp *MovieListResponse, statusCode int, err error) {
 	// Create the HTTP request
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, GetNowPlayingMoviesURL, http.NoBody)
+	req, err := buildRequest(ctx, "GetMoviesPopular", http.MethodGet, GetPopularMoviesURL, nil)
 	if err != nil {
-		return nil, http.StatusInternalServerError, fmt.Errorf(ErrBuildingRequest, err)
+		return nil, 0, err
 	}
 
-	// Add the Authorization header
-	c.addAuthorizationToRequest(req)
-
 	// Add query parameters
-	AddMovieListsQueryParameters(req, language, page, region)
-
-	// Make the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf(ErrAnErrOcurredDuringRequest, err)
+	if err = AddQueryParameters(req, opts); err != nil {
+		return nil, 0, fmt.Errorf(ErrBuildingRequest, err)
 	}
-	defer resp.Body.Close()
+	c.addDefaultQueryParameters(req, Language, Region)
 
-	// Check for non-200 status codes
-	if resp.StatusCode != http.StatusOK {
-		// nolint:errcheck
-		body, _ := io.ReadAll(resp.Body)
-		return nil, resp.StatusCode, fmt.Errorf(ErrRequestFailed, resp.StatusCode, string(body))
+	// Make the HTTP request and parse the response
+	parsedResp = &MovieListResponse{}
+	if req, statusCode, err = c.sendRequest(req, parsedResp, c.addAuthorizationToRequest); err != nil {
+		return nil, statusCode, err
 	}
 
-	// Parse the response
-	parsedResp = &DateMovieListResponse{}
-	if parseErr := json.NewDecoder(resp.Body).Decode(parsedResp); parseErr != nil {
-		return nil, resp.StatusCode, ErrResponseParsing
-	}
-	return parsedResp, resp.StatusCode, nil
+	// Fill the empty localized fields from the language fallback chain
+	c.applyMoviesFallback(req, parsedResp.Results)
+	return parsedResp, statusCode, nil
 }
 
 // GetMoviesPopular fetches the list of popular movies
@@ -112,47 +437,56 @@ func (c Client) GetMoviesNowPlaying(
 // Returns:
 //
 // - (*MovieListResponse): the response containing the list of popular movies
-// - int: the HTTP status code
+// - int: the HTTP status code, or 0 if no response was received
 // - error: if there was an error fetching the movies
+//
+// Deprecated: use GetMoviesPopularWithOptions instead.
 func (c Client) GetMoviesPopular(
 	ctx context.Context,
 	language string,
 	page int32,
 	region string,
+) (parsedResp *MovieListResponse, statusCode int, err error) {
+	return c.GetMoviesPopularWithOptions(ctx, &MovieListOptions{Language: language, Page: page, Region: region})
+}
+
+// GetMoviesTopRatedWithOptions fetches the list of top-rated movies
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - opts: the language, page and region of the list (optional)
+//
+// Returns:
+//
+// - (*MovieListResponse): the response containing the list of top-rated movies
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the movies
+func (c Client) GetMoviesTopRatedWithOptions(
+	ctx context.Context,
+	opts *MovieListOptions,
 ) (parsedResp *MovieListResponse, statusCode int, err error) {
 	// Create the HTTP request
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, GetPopularMoviesURL, http.NoBody)
+	req, err := buildRequest(ctx, "GetMoviesTopRated", http.MethodGet, GetTopRatedMoviesURL, nil)
 	if err != nil {
-		return nil, http.StatusInternalServerError, fmt.Errorf(ErrBuildingRequest, err)
+		return nil, 0, err
 	}
 
-	// Add the Authorization header
-	c.addAuthorizationToRequest(req)
-
 	// Add query parameters
-	AddMovieListsQueryParameters(req, language, page, region)
-
-	// Make the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf(ErrAnErrOcurredDuringRequest, err)
-	}
-	defer resp.Body.Close()
-
-	// Check for non-200 status codes
-	if resp.StatusCode != http.StatusOK {
-		// nolint:errcheck
-		body, _ := io.ReadAll(resp.Body)
-		return nil, resp.StatusCode, fmt.Errorf(ErrRequestFailed, resp.StatusCode, string(body))
+	if err = AddQueryParameters(req, opts); err != nil {
+		return nil, 0, fmt.Errorf(ErrBuildingRequest, err)
 	}
+	c.addDefaultQueryParameters(req, Language, Region)
 
-	// Parse the response
+	// Make the HTTP request and parse the response
 	parsedResp = &MovieListResponse{}
-	if parseErr := json.NewDecoder(resp.Body).Decode(parsedResp); parseErr != nil {
-		return nil, resp.StatusCode, ErrResponseParsing
+	if req, statusCode, err = c.sendRequest(req, parsedResp, c.addAuthorizationToRequest); err != nil {
+		return nil, statusCode, err
 	}
-	return parsedResp, resp.StatusCode, nil
+
+	// Fill the empty localized fields from the language fallback chain
+	c.applyMoviesFallback(req, parsedResp.Results)
+	return parsedResp, statusCode, nil
 }
 
 // GetMoviesTopRated fetches the list of top-rated movies
@@ -167,47 +501,56 @@ func (c Client) GetMoviesPopular(
 // Returns:
 //
 // - (*MovieListResponse): the response containing the list of top-rated movies
-// - int: the HTTP status code
+// - int: the HTTP status code, or 0 if no response was received
 // - error: if there was an error fetching the movies
+//
+// Deprecated: use GetMoviesTopRatedWithOptions instead.
 func (c Client) GetMoviesTopRated(
 	ctx context.Context,
 	language string,
 	page int32,
 	region string,
-) (parsedResp *MovieListResponse, httpStatus int, err error) {
+) (parsedResp *MovieListResponse, statusCode int, err error) {
+	return c.GetMoviesTopRatedWithOptions(ctx, &MovieListOptions{Language: language, Page: page, Region: region})
+}
+
+// GetMoviesUpcomingWithOptions fetches the list of upcoming movies
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - opts: the language, page and region of the list (optional)
+//
+// Returns:
+//
+// - (*DateMovieListResponse): the response containing the list of upcoming movies
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the movies
+func (c Client) GetMoviesUpcomingWithOptions(
+	ctx context.Context,
+	opts *MovieListOptions,
+) (parsedResp *DateMovieListResponse, statusCode int, err error) {
 	// Create the HTTP request
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, GetTopRatedMoviesURL, http.NoBody)
+	req, err := buildRequest(ctx, "GetMoviesUpcoming", http.MethodGet, GetUpcomingMoviesURL, nil)
 	if err != nil {
-		return nil, http.StatusInternalServerError, fmt.Errorf(ErrBuildingRequest, err)
+		return nil, 0, err
 	}
 
-	// Add the Authorization header
-	c.addAuthorizationToRequest(req)
-
 	// Add query parameters
-	AddMovieListsQueryParameters(req, language, page, region)
-
-	// Make the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf(ErrAnErrOcurredDuringRequest, err)
+	if err = AddQueryParameters(req, opts); err != nil {
+		return nil, 0, fmt.Errorf(ErrBuildingRequest, err)
 	}
-	defer resp.Body.Close()
+	c.addDefaultQueryParameters(req, Language, Region)
 
-	// Check for non-200 status codes
-	if resp.StatusCode != http.StatusOK {
-		// nolint:errcheck
-		body, _ := io.ReadAll(resp.Body)
-		return nil, resp.StatusCode, fmt.Errorf(ErrRequestFailed, resp.StatusCode, string(body))
+	// Make the HTTP request and parse the response
+	parsedResp = &DateMovieListResponse{}
+	if req, statusCode, err = c.sendRequest(req, parsedResp, c.addAuthorizationToRequest); err != nil {
+		return nil, statusCode, err
 	}
 
-	// Parse the response
-	parsedResp = &MovieListResponse{}
-	if parseErr := json.NewDecoder(resp.Body).Decode(parsedResp); parseErr != nil {
-		return nil, resp.StatusCode, ErrResponseParsing
-	}
-	return parsedResp, resp.StatusCode, nil
+	// Fill the empty localized fields from the language fallback chain
+	c.applyMoviesFallback(req, parsedResp.Results)
+	return parsedResp, statusCode, nil
 }
 
 // GetMoviesUpcoming fetches the list of upcoming movies
@@ -222,47 +565,61 @@ func (c Client) GetMoviesTopRated(
 // Returns:
 //
 // - (*DateMovieListResponse): the response containing the list of upcoming movies
-// - int: the HTTP status code
+// - int: the HTTP status code, or 0 if no response was received
 // - error: if there was an error fetching the movies
+//
+// Deprecated: use GetMoviesUpcomingWithOptions instead.
 func (c Client) GetMoviesUpcoming(
 	ctx context.Context,
 	language string,
 	page int32,
 	region string,
 ) (parsedResp *DateMovieListResponse, statusCode int, err error) {
+	return c.GetMoviesUpcomingWithOptions(ctx, &MovieListOptions{Language: language, Page: page, Region: region})
+}
+
+// SearchMoviesWithOptions searches for movies by query
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - query: the search query
+// - opts: the filters and pagination of the search (optional)
+//
+// Returns:
+//
+// - (*MovieListResponse): the response containing the list of movies matching the search query
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error searching for movies
+func (c Client) SearchMoviesWithOptions(
+	ctx context.Context,
+	query string,
+	opts *SearchMoviesOptions,
+) (parsedResp *MovieListResponse, statusCode int, err error) {
 	// Create the HTTP request
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, GetUpcomingMoviesURL, http.NoBody)
+	req, err := buildRequest(ctx, "SearchMovies", http.MethodGet, SearchMoviesURL, nil)
 	if err != nil {
-		return nil, http.StatusInternalServerError, fmt.Errorf(ErrBuildingRequest, err)
+		return nil, 0, err
 	}
 
-	// Add the Authorization header
-	c.addAuthorizationToRequest(req)
-
 	// Add query parameters
-	AddMovieListsQueryParameters(req, language, page, region)
-
-	// Make the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf(ErrAnErrOcurredDuringRequest, err)
+	q := req.URL.Query()
+	q.Add(Query, query)
+	if err = EncodeQueryParameters(q, opts); err != nil {
+		return nil, 0, fmt.Errorf(ErrBuildingRequest, err)
 	}
-	defer resp.Body.Close()
+	req.URL.RawQuery = q.Encode()
+	c.addDefaultQueryParameters(req, Language, Region, IncludeAdult)
 
-	// Check for non-200 status codes
-	if resp.StatusCode != http.StatusOK {
-		// nolint:errcheck
-		body, _ := io.ReadAll(resp.Body)
-		return nil, resp.StatusCode, fmt.Errorf(ErrRequestFailed, resp.StatusCode, string(body))
+	// Make the HTTP request and parse the response
+	parsedResp = &MovieListResponse{}
+	if req, statusCode, err = c.sendRequest(req, parsedResp, c.addAuthorizationToRequest); err != nil {
+		return nil, statusCode, err
 	}
 
-	// Parse the response
-	parsedResp = &DateMovieListResponse{}
-	if parseErr := json.NewDecoder(resp.Body).Decode(parsedResp); parseErr != nil {
-		return nil, resp.StatusCode, ErrResponseParsing
-	}
-	return parsedResp, resp.StatusCode, nil
+	// Fill the empty localized fields from the language fallback chain
+	c.applyMoviesFallback(req, parsedResp.Results)
+	return parsedResp, statusCode, nil
 }
 
 // SearchMovies searches for movies by query
@@ -281,8 +638,10 @@ func (c Client) GetMoviesUpcoming(
 // Returns:
 //
 // - (*MovieListResponse): the response containing the list of movies matching the search query
-// - int: the HTTP status code
+// - int: the HTTP status code, or 0 if no response was received
 // - error: if there was an error searching for movies
+//
+// Deprecated: use SearchMoviesWithOptions instead.
 func (c Client) SearchMovies(
 	ctx context.Context,
 	query string,
@@ -293,104 +652,83 @@ func (c Client) SearchMovies(
 	region string,
 	year int32,
 ) (parsedresp *MovieListResponse, statusCode int, err error) {
-	// Create the HTTP request
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, SearchMoviesURL, http.NoBody)
-	if err != nil {
-		return nil, http.StatusInternalServerError, fmt.Errorf(ErrBuildingRequest, err)
-	}
-
-	// Add the Authorization header
-	c.addAuthorizationToRequest(req)
-
-	// Add query parameters
-	AddSearchMoviesQueryParameters(
-		req,
-		query,
-		includeAdult,
-		language,
-		primaryReleaseYear,
-		page,
-		region,
-		year,
+	return c.SearchMoviesWithOptions(
+		ctx, query, &SearchMoviesOptions{
+			IncludeAdult:       &includeAdult,
+			Language:           language,
+			PrimaryReleaseYear: primaryReleaseYear,
+			Page:               page,
+			Region:             region,
+			Year:               year,
+		},
 	)
-
-	// Make the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf(ErrAnErrOcurredDuringRequest, err)
-	}
-	defer resp.Body.Close()
-
-	// Check for non-200 status codes
-	if resp.StatusCode != http.StatusOK {
-		// nolint:errcheck
-		body, _ := io.ReadAll(resp.Body)
-		return nil, resp.StatusCode, fmt.Errorf(ErrRequestFailed, resp.StatusCode, string(body))
-	}
-
-	// Parse the response
-	var parsedResp MovieListResponse
-	if parseErr := json.NewDecoder(resp.Body).Decode(&parsedResp); parseErr != nil {
-		return nil, resp.StatusCode, ErrResponseParsing
-	}
-	return &parsedResp, resp.StatusCode, nil
 }
 
-// SimilarMovies fetches the list of movies similar to a given movie
+// SimilarMoviesWithOptions fetches the list of movies similar to a given movie
 //
 // Parameters:
 //
 // - ctx: the context of the request
 // - movieID: the ID of the movie
-// - language: the language code (optional, defaults to "en-US")
-// - page: the page number (optional, defaults to 1)
+// - opts: the language and page of the list (optional)
 //
 // Returns:
 //
 // - (*MovieListResponse): the response containing the list of similar movies
-// - int: the HTTP status code
+// - int: the HTTP status code, or 0 if no response was received
 // - error: if there was an error fetching similar movies
-func (c Client) SimilarMovies(
+func (c Client) SimilarMoviesWithOptions(
 	ctx context.Context,
-	movieID int32,
-	language string,
-	page int32,
+	movieID MovieID,
+	opts *PageOptions,
 ) (parsedResp *MovieListResponse, statusCode int, err error) {
 	// Create the HTTP request
 	apiURL := fmt.Sprintf(SimilarMoviesURL, fmt.Sprintf("%d", movieID))
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, http.NoBody)
+	req, err := buildRequest(ctx, "SimilarMovies", http.MethodGet, apiURL, nil)
 	if err != nil {
-		return nil, http.StatusInternalServerError, fmt.Errorf(ErrBuildingRequest, err)
+		return nil, 0, err
 	}
 
-	// Add the Authorization header
-	c.addAuthorizationToRequest(req)
-
 	// Add query parameters
-	AddSimilarMoviesQueryParameters(req, language, page)
-
-	// Make the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf(ErrAnErrOcurredDuringRequest, err)
-	}
-	defer resp.Body.Close()
-
-	// Check for non-200 status codes
-	if resp.StatusCode != http.StatusOK {
-		// nolint:errcheck
-		body, _ := io.ReadAll(resp.Body)
-		return nil, resp.StatusCode, fmt.Errorf(ErrRequestFailed, resp.StatusCode, string(body))
+	if err = AddQueryParameters(req, opts); err != nil {
+		return nil, 0, fmt.Errorf(ErrBuildingRequest, err)
 	}
+	c.addDefaultQueryParameters(req, Language)
 
-	// Parse the response
+	// Make the HTTP request and parse the response
 	parsedResp = &MovieListResponse{}
-	if parseErr := json.NewDecoder(resp.Body).Decode(parsedResp); parseErr != nil {
-		return nil, resp.StatusCode, ErrResponseParsing
+	if req, statusCode, err = c.sendRequest(req, parsedResp, c.addAuthorizationToRequest); err != nil {
+		return nil, statusCode, err
 	}
-	return parsedResp, resp.StatusCode, nil
+
+	// Fill the empty localized fields from the language fallback chain
+	c.applyMoviesFallback(req, parsedResp.Results)
+	return parsedResp, statusCode, nil
+}
+
+// SimilarMovies fetches the list of movies similar to a given movie
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - movieID: the ID of the movie
+// - language: the language code (optional, defaults to "en-US")
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*MovieListResponse): the response containing the list of similar movies
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching similar movies
+//
+// Deprecated: use SimilarMoviesWithOptions instead.
+func (c Client) SimilarMovies(
+	ctx context.Context,
+	movieID MovieID,
+	language string,
+	page int32,
+) (parsedResp *MovieListResponse, statusCode int, err error) {
+	return c.SimilarMoviesWithOptions(ctx, movieID, &PageOptions{Language: language, Page: page})
 }
 
 // GetMovieCredits fetches the credits for a given movie
@@ -404,49 +742,32 @@ func (c Client) SimilarMovies(
 // Returns:
 //
 // - (*MovieCreditsResponse): the response containing the movie credits
-// - int: the HTTP status code
+// - int: the HTTP status code, or 0 if no response was received
 // - error: if there was an error fetching the movie credits
 func (c Client) GetMovieCredits(
 	ctx context.Context,
-	movieID int32,
+	movieID MovieID,
 	language string,
 ) (parsedResp *MovieCreditsResponse, statusCode int, err error) {
 	// Create the HTTP request
 	apiURL := fmt.Sprintf(GetMovieCreditsURL, fmt.Sprintf("%d", movieID))
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, http.NoBody)
+	req, err := buildRequest(ctx, "GetMovieCredits", http.MethodGet, apiURL, nil)
 	if err != nil {
-		return nil, http.StatusInternalServerError, fmt.Errorf(ErrBuildingRequest, err)
+		return nil, 0, err
 	}
 
-	// Add the Authorization header
-	c.addAuthorizationToRequest(req)
-
 	// Add query parameters
 	q := req.URL.Query()
 	AddLanguageQueryParameter(q, language)
 	req.URL.RawQuery = q.Encode()
+	c.addDefaultQueryParameters(req, Language)
 
-	// Make the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf(ErrAnErrOcurredDuringRequest, err)
-	}
-	defer resp.Body.Close()
-
-	// Check for non-200 status codes
-	if resp.StatusCode != http.StatusOK {
-		// nolint:errcheck
-		body, _ := io.ReadAll(resp.Body)
-		return nil, resp.StatusCode, fmt.Errorf(ErrRequestFailed, resp.StatusCode, string(body))
-	}
-
-	// Parse the response
+	// Make the HTTP request and parse the response
 	parsedResp = &MovieCreditsResponse{}
-	if parseErr := json.NewDecoder(resp.Body).Decode(parsedResp); parseErr != nil {
-		return nil, resp.StatusCode, ErrResponseParsing
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
 	}
-	return parsedResp, resp.StatusCode, nil
+	return parsedResp, statusCode, nil
 }
 
 // GetMovieDetails fetches the details of a given movie
@@ -460,49 +781,105 @@ func (c Client) GetMovieCredits(
 // Returns:
 //
 // - (*MovieDetailsResponse): the response containing the movie details
-// - int: the HTTP status code
+// - int: the HTTP status code, or 0 if no response was received
 // - error: if there was an error fetching the movie details
 func (c Client) GetMovieDetails(
 	ctx context.Context,
-	movieID int32,
+	movieID MovieID,
 	language string,
 ) (parsedResp *MovieDetailsResponse, statusCode int, err error) {
 	// Create the HTTP request
 	apiURL := fmt.Sprintf(GetMovieDetailsURL, fmt.Sprintf("%d", movieID))
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, http.NoBody)
+	req, err := buildRequest(ctx, "GetMovieDetails", http.MethodGet, apiURL, nil)
 	if err != nil {
-		return nil, http.StatusInternalServerError, fmt.Errorf(ErrBuildingRequest, err)
+		return nil, 0, err
 	}
 
-	// Add the Authorization header
-	c.addAuthorizationToRequest(req)
-
 	// Add query parameters
 	q := req.URL.Query()
 	AddLanguageQueryParameter(q, language)
 	req.URL.RawQuery = q.Encode()
+	c.addDefaultQueryParameters(req, Language)
 
-	// Make the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	// Make the HTTP request and parse the response
+	parsedResp = &MovieDetailsResponse{}
+	if req, statusCode, err = c.sendRequest(req, parsedResp, c.addAuthorizationToRequest); err != nil {
+		return nil, statusCode, err
+	}
+
+	// Fill the empty localized fields from the language fallback chain
+	c.applyMovieDetailsFallback(req, parsedResp)
+	return parsedResp, statusCode, nil
+}
+
+// GetMovieTranslations fetches the translations of a given movie
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - movieID: the ID of the movie
+//
+// Returns:
+//
+// - (*MovieTranslationsResponse): the response containing the movie translations
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the movie translations
+func (c Client) GetMovieTranslations(
+	ctx context.Context,
+	movieID MovieID,
+) (parsedResp *MovieTranslationsResponse, statusCode int, err error) {
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(GetMovieTranslationsURL, fmt.Sprintf("%d", movieID))
+	req, err := buildRequest(ctx, "GetMovieTranslations", http.MethodGet, apiURL, nil)
 	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf(ErrAnErrOcurredDuringRequest, err)
+		return nil, 0, err
 	}
-	defer resp.Body.Close()
 
-	// Check for non-200 status codes
-	if resp.StatusCode != http.StatusOK {
-		// nolint:errcheck
-		body, _ := io.ReadAll(resp.Body)
-		return nil, resp.StatusCode, fmt.Errorf(ErrRequestFailed, resp.StatusCode, string(body))
+	// Make the HTTP request and parse the response
+	parsedResp = &MovieTranslationsResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
 	}
+	return parsedResp, statusCode, nil
+}
 
-	// Parse the response
-	parsedResp = &MovieDetailsResponse{}
-	if parseErr := json.NewDecoder(resp.Body).Decode(parsedResp); parseErr != nil {
-		return nil, resp.StatusCode, ErrResponseParsing
+// GetMovieReviewsWithOptions fetches the reviews for a given movie
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - movieID: the ID of the movie
+// - opts: the language and page of the list (optional)
+//
+// Returns:
+//
+// - (*MovieReviewsResponse): the response containing the movie reviews
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the movie reviews
+func (c Client) GetMovieReviewsWithOptions(
+	ctx context.Context,
+	movieID MovieID,
+	opts *PageOptions,
+) (parsedResp *MovieReviewsResponse, statusCode int, err error) {
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(GetMovieReviewsURL, fmt.Sprintf("%d", movieID))
+	req, err := buildRequest(ctx, "GetMovieReviews", http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Add query parameters
+	if err = AddQueryParameters(req, opts); err != nil {
+		return nil, 0, fmt.Errorf(ErrBuildingRequest, err)
+	}
+	c.addDefaultQueryParameters(req, Language)
+
+	// Make the HTTP request and parse the response
+	parsedResp = &MovieReviewsResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
 	}
-	return parsedResp, resp.StatusCode, nil
+	return parsedResp, statusCode, nil
 }
 
 // GetMovieReviews fetches the reviews for a given movie
@@ -517,154 +894,2288 @@ func (c Client) GetMovieDetails(
 // Returns:
 //
 // - (*MovieReviewsResponse): the response containing the movie reviews
-// - int: the HTTP status code
+// - int: the HTTP status code, or 0 if no response was received
 // - error: if there was an error fetching the movie reviews
+//
+// Deprecated: use GetMovieReviewsWithOptions instead.
 func (c Client) GetMovieReviews(
 	ctx context.Context,
-	movieID int32,
+	movieID MovieID,
 	language string,
 	page int32,
 ) (parsedResp *MovieReviewsResponse, statusCode int, err error) {
+	return c.GetMovieReviewsWithOptions(ctx, movieID, &PageOptions{Language: language, Page: page})
+}
+
+// GetGenresMovieList fetches the list of movie genres
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - language: the language code (optional, defaults to "en-US")
+//
+// Returns:
+//
+// - (*GenreListResponse): the response containing the list of movie genres
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the movie genres
+func (c Client) GetGenresMovieList(
+	ctx context.Context,
+	language string,
+) (parsedResp *GenreListResponse, statusCode int, err error) {
 	// Create the HTTP request
-	apiURL := fmt.Sprintf(GetMovieReviewsURL, fmt.Sprintf("%d", movieID))
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, http.NoBody)
+	req, err := buildRequest(ctx, "GetGenresMovieList", http.MethodGet, GetGenresMovieListURL, nil)
 	if err != nil {
-		return nil, http.StatusInternalServerError, fmt.Errorf(ErrBuildingRequest, err)
+		return nil, 0, err
 	}
 
-	// Add the Authorization header
-	c.addAuthorizationToRequest(req)
-
 	// Add query parameters
 	q := req.URL.Query()
 	AddLanguageQueryParameter(q, language)
-	AddPageQueryParameter(q, page)
 	req.URL.RawQuery = q.Encode()
+	c.addDefaultQueryParameters(req, Language)
 
-	// Make the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	// Make the HTTP request and parse the response
+	parsedResp = &GenreListResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// DiscoverMovies discovers movies based on various criteria
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - queryParameters: the query parameters, which are validated before making the request (optional)
+//
+// Returns:
+//
+// - (*MovieListResponse): the response containing the list of discovered movies
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if the query parameters are not valid or there was an error discovering movies
+func (c Client) DiscoverMovies(
+	ctx context.Context,
+	queryParameters *DiscoverMoviesQueryParameters,
+) (parsedResp *MovieListResponse, statusCode int, err error) {
+	// Apply the default watch region, since the watch providers filters depend on it
+	params := DiscoverMoviesQueryParameters{}
+	if queryParameters != nil {
+		params = *queryParameters
+	}
+	if params.WatchRegion == "" {
+		params.WatchRegion = c.resolveDefaults(ctx).WatchRegion
+	}
+
+	// Validate the query parameters before making the request
+	if err = params.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	// Create the HTTP request
+	req, err := buildRequest(ctx, "DiscoverMovies", http.MethodGet, DiscoverMoviesURL, nil)
 	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf(ErrAnErrOcurredDuringRequest, err)
+		return nil, 0, err
 	}
-	defer resp.Body.Close()
 
-	// Check for non-200 status codes
-	if resp.StatusCode != http.StatusOK {
-		// nolint:errcheck
-		body, _ := io.ReadAll(resp.Body)
-		return nil, resp.StatusCode, fmt.Errorf(ErrRequestFailed, resp.StatusCode, string(body))
+	// Add query parameters
+	if err = AddGenreMovieListQueryParameters(req, &params); err != nil {
+		return nil, 0, fmt.Errorf(ErrBuildingRequest, err)
 	}
+	c.addDefaultQueryParameters(req, Language, Region, IncludeAdult)
 
-	// Parse the response
-	parsedResp = &MovieReviewsResponse{}
-	if parseErr := json.NewDecoder(resp.Body).Decode(parsedResp); parseErr != nil {
-		return nil, resp.StatusCode, ErrResponseParsing
+	// Make the HTTP request and parse the response
+	parsedResp = &MovieListResponse{}
+	if req, statusCode, err = c.sendRequest(req, parsedResp, c.addAuthorizationToRequest); err != nil {
+		return nil, statusCode, err
 	}
-	return parsedResp, resp.StatusCode, nil
+
+	// Fill the empty localized fields from the language fallback chain
+	c.applyMoviesFallback(req, parsedResp.Results)
+	return parsedResp, statusCode, nil
 }
 
-// GetGenresMovieList fetches the list of movie genres
+// GetCompanyDetails fetches the details of a given company
 //
 // Parameters:
 //
 // - ctx: the context of the request
-// - language: the language code (optional, defaults to "en-US")
+// - companyID: the ID of the company
 //
 // Returns:
 //
-// - (*GenreListResponse): the response containing the list of movie genres
-// - int: the HTTP status code
-// - error: if there was an error fetching the movie genres
-func (c Client) GetGenresMovieList(
+// - (*CompanyDetailsResponse): the response containing the company details
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the company details
+func (c Client) GetCompanyDetails(
 	ctx context.Context,
-	language string,
-) (parsedResp *GenreListResponse, statusCode int, err error) {
+	companyID CompanyID,
+) (parsedResp *CompanyDetailsResponse, statusCode int, err error) {
 	// Create the HTTP request
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, GetGenresMovieListURL, http.NoBody)
+	apiURL := fmt.Sprintf(GetCompanyDetailsURL, fmt.Sprintf("%d", companyID))
+	req, err := buildRequest(ctx, "GetCompanyDetails", http.MethodGet, apiURL, nil)
 	if err != nil {
-		return nil, http.StatusInternalServerError, fmt.Errorf(ErrBuildingRequest, err)
+		return nil, 0, err
 	}
 
-	// Add the Authorization header
-	c.addAuthorizationToRequest(req)
+	// Make the HTTP request and parse the response
+	parsedResp = &CompanyDetailsResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
 
-	// Add query parameters
-	q := req.URL.Query()
-	AddLanguageQueryParameter(q, language)
-	req.URL.RawQuery = q.Encode()
+// GetCompanyAlternativeNames fetches the alternative names of a given company
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - companyID: the ID of the company
+//
+// Returns:
+//
+// - (*CompanyAlternativeNamesResponse): the response containing the company alternative names
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the company alternative names
+func (c Client) GetCompanyAlternativeNames(
+	ctx context.Context,
+	companyID CompanyID,
+) (parsedResp *CompanyAlternativeNamesResponse, statusCode int, err error) {
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(GetCompanyAlternativeNamesURL, fmt.Sprintf("%d", companyID))
+	req, err := buildRequest(ctx, "GetCompanyAlternativeNames", http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
 
-	// Make the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	// Make the HTTP request and parse the response
+	parsedResp = &CompanyAlternativeNamesResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetCompanyImages fetches the logos of a given company
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - companyID: the ID of the company
+//
+// Returns:
+//
+// - (*LogoImagesResponse): the response containing the company logos
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the company images
+func (c Client) GetCompanyImages(
+	ctx context.Context,
+	companyID CompanyID,
+) (parsedResp *LogoImagesResponse, statusCode int, err error) {
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(GetCompanyImagesURL, fmt.Sprintf("%d", companyID))
+	req, err := buildRequest(ctx, "GetCompanyImages", http.MethodGet, apiURL, nil)
 	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf(ErrAnErrOcurredDuringRequest, err)
+		return nil, 0, err
 	}
-	defer resp.Body.Close()
 
-	// Check for non-200 status codes
-	if resp.StatusCode != http.StatusOK {
-		// nolint:errcheck
-		body, _ := io.ReadAll(resp.Body)
-		return nil, resp.StatusCode, fmt.Errorf(ErrRequestFailed, resp.StatusCode, string(body))
+	// Make the HTTP request and parse the response
+	parsedResp = &LogoImagesResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
 	}
+	return parsedResp, statusCode, nil
+}
 
-	// Parse the response
-	parsedResp = &GenreListResponse{}
-	if parseErr := json.NewDecoder(resp.Body).Decode(parsedResp); parseErr != nil {
-		return nil, resp.StatusCode, ErrResponseParsing
+// GetNetworkDetails fetches the details of a given TV network
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - networkID: the ID of the network
+//
+// Returns:
+//
+// - (*NetworkDetailsResponse): the response containing the network details
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the network details
+func (c Client) GetNetworkDetails(
+	ctx context.Context,
+	networkID int32,
+) (parsedResp *NetworkDetailsResponse, statusCode int, err error) {
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(GetNetworkDetailsURL, fmt.Sprintf("%d", networkID))
+	req, err := buildRequest(ctx, "GetNetworkDetails", http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Make the HTTP request and parse the response
+	parsedResp = &NetworkDetailsResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
 	}
-	return parsedResp, resp.StatusCode, nil
+	return parsedResp, statusCode, nil
 }
 
-// DiscoverMovies discovers movies based on various criteria
+// GetNetworkImages fetches the logos of a given TV network
 //
 // Parameters:
 //
 // - ctx: the context of the request
+// - networkID: the ID of the network
 //
 // Returns:
 //
-// - (*MovieListResponse): the response containing the list of discovered movies
-// - int: the HTTP status code
-// - error: if there was an error discovering movies
-func (c Client) DiscoverMovies(
+// - (*LogoImagesResponse): the response containing the network logos
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the network images
+func (c Client) GetNetworkImages(
 	ctx context.Context,
-	queryParameters *DiscoverMoviesQueryParameters,
-) (parsedResp *MovieListResponse, statusCode int, err error) {
+	networkID int32,
+) (parsedResp *LogoImagesResponse, statusCode int, err error) {
 	// Create the HTTP request
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, DiscoverMoviesURL, http.NoBody)
+	apiURL := fmt.Sprintf(GetNetworkImagesURL, fmt.Sprintf("%d", networkID))
+	req, err := buildRequest(ctx, "GetNetworkImages", http.MethodGet, apiURL, nil)
 	if err != nil {
-		return nil, http.StatusInternalServerError, fmt.Errorf(ErrBuildingRequest, err)
+		return nil, 0, err
+	}
+
+	// Make the HTTP request and parse the response
+	parsedResp = &LogoImagesResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
 	}
+	return parsedResp, statusCode, nil
+}
 
-	// Add the Authorization header
-	c.addAuthorizationToRequest(req)
+// GetKeywordDetails fetches the details of a given keyword
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - keywordID: the ID of the keyword
+//
+// Returns:
+//
+// - (*KeywordDetailsResponse): the response containing the keyword details
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the keyword details
+func (c Client) GetKeywordDetails(
+	ctx context.Context,
+	keywordID KeywordID,
+) (parsedResp *KeywordDetailsResponse, statusCode int, err error) {
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(GetKeywordDetailsURL, fmt.Sprintf("%d", keywordID))
+	req, err := buildRequest(ctx, "GetKeywordDetails", http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
 
-	// Add query parameters
-	if queryParameters != nil {
-		AddGenreMovieListQueryParameters(req, queryParameters)
+	// Make the HTTP request and parse the response
+	parsedResp = &KeywordDetailsResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
 	}
+	return parsedResp, statusCode, nil
+}
 
-	// Make the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+// GetKeywordMoviesWithOptions fetches the list of movies tagged with a given keyword
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - keywordID: the ID of the keyword
+// - opts: the filters and pagination of the movies (optional)
+//
+// Returns:
+//
+// - (*KeywordMoviesResponse): the response containing the list of movies tagged with the keyword
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the keyword movies
+func (c Client) GetKeywordMoviesWithOptions(
+	ctx context.Context,
+	keywordID KeywordID,
+	opts *KeywordMoviesOptions,
+) (parsedResp *KeywordMoviesResponse, statusCode int, err error) {
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(GetKeywordMoviesURL, fmt.Sprintf("%d", keywordID))
+	req, err := buildRequest(ctx, "GetKeywordMovies", http.MethodGet, apiURL, nil)
 	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf(ErrAnErrOcurredDuringRequest, err)
+		return nil, 0, err
 	}
-	defer resp.Body.Close()
 
-	// Check for non-200 status codes
-	if resp.StatusCode != http.StatusOK {
-		// nolint:errcheck
-		body, _ := io.ReadAll(resp.Body)
-		return nil, resp.StatusCode, fmt.Errorf(ErrRequestFailed, resp.StatusCode, string(body))
+	// Add query parameters
+	q := req.URL.Query()
+	if err = EncodeQueryParameters(q, opts); err != nil {
+		return nil, 0, fmt.Errorf(ErrBuildingRequest, err)
 	}
+	req.URL.RawQuery = q.Encode()
+	c.addDefaultQueryParameters(req, Language, IncludeAdult)
 
-	// Parse the response
-	parsedResp = &MovieListResponse{}
-	if parseErr := json.NewDecoder(resp.Body).Decode(parsedResp); parseErr != nil {
-		return nil, resp.StatusCode, ErrResponseParsing
+	// Make the HTTP request and parse the response
+	parsedResp = &KeywordMoviesResponse{}
+	if req, statusCode, err = c.sendRequest(req, parsedResp, c.addAuthorizationToRequest); err != nil {
+		return nil, statusCode, err
+	}
+
+	// Fill the empty localized fields from the language fallback chain
+	c.applyMoviesFallback(req, parsedResp.Results)
+	return parsedResp, statusCode, nil
+}
+
+// GetKeywordMovies fetches the list of movies tagged with a given keyword
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - keywordID: the ID of the keyword
+// - includeAdult: whether to include adult content
+// - language: the language code (optional, defaults to "en-US")
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*KeywordMoviesResponse): the response containing the list of movies tagged with the keyword
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the keyword movies
+//
+// Deprecated: use GetKeywordMoviesWithOptions instead.
+func (c Client) GetKeywordMovies(
+	ctx context.Context,
+	keywordID KeywordID,
+	includeAdult bool,
+	language string,
+	page int32,
+) (parsedResp *KeywordMoviesResponse, statusCode int, err error) {
+	return c.GetKeywordMoviesWithOptions(
+		ctx, keywordID, &KeywordMoviesOptions{IncludeAdult: &includeAdult, Language: language, Page: page},
+	)
+}
+
+// getChangeList fetches a list of changed items from the given TMDB API URL
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - endpoint: the endpoint name, used by the middlewares, hooks and logs
+// - apiURL: the TMDB API URL
+// - startDate: the start date (optional)
+// - endDate: the end date (optional)
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*ChangeListResponse): the response containing the list of changed items
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the changed items
+func (c Client) getChangeList(
+	ctx context.Context,
+	endpoint string,
+	apiURL string,
+	startDate Date,
+	endDate Date,
+	page int32,
+) (parsedResp *ChangeListResponse, statusCode int, err error) {
+	// Validate the changes window
+	if err = ValidateChangesWindow(startDate, endDate); err != nil {
+		return nil, 0, err
+	}
+
+	// Create the HTTP request
+	req, err := buildRequest(ctx, endpoint, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Add query parameters
+	AddChangesQueryParameters(req, startDate, endDate, page)
+
+	// Make the HTTP request and parse the response
+	parsedResp = &ChangeListResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// getChanges fetches the changes of an item from the given TMDB API URL
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - endpoint: the endpoint name, used by the middlewares, hooks and logs
+// - apiURL: the TMDB API URL
+// - startDate: the start date (optional)
+// - endDate: the end date (optional)
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*ChangesResponse): the response containing the changes of the item
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the changes
+func (c Client) getChanges(
+	ctx context.Context,
+	endpoint string,
+	apiURL string,
+	startDate Date,
+	endDate Date,
+	page int32,
+) (parsedResp *ChangesResponse, statusCode int, err error) {
+	// Validate the changes window
+	if err = ValidateChangesWindow(startDate, endDate); err != nil {
+		return nil, 0, err
+	}
+
+	// Create the HTTP request
+	req, err := buildRequest(ctx, endpoint, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Add query parameters
+	AddChangesQueryParameters(req, startDate, endDate, page)
+
+	// Make the HTTP request and parse the response
+	parsedResp = &ChangesResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetMovieChangeList fetches the list of movies that have changed in the given window
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - startDate: the start date (optional)
+// - endDate: the end date (optional, at most 14 days after the start date)
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*ChangeListResponse): the response containing the list of changed movies
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the changed movies
+func (c Client) GetMovieChangeList(
+	ctx context.Context,
+	startDate Date,
+	endDate Date,
+	page int32,
+) (*ChangeListResponse, int, error) {
+	return c.getChangeList(ctx, "GetMovieChangeList", GetMovieChangeListURL, startDate, endDate, page)
+}
+
+// GetTVChangeList fetches the list of TV shows that have changed in the given window
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - startDate: the start date (optional)
+// - endDate: the end date (optional, at most 14 days after the start date)
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*ChangeListResponse): the response containing the list of changed TV shows
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the changed TV shows
+func (c Client) GetTVChangeList(
+	ctx context.Context,
+	startDate Date,
+	endDate Date,
+	page int32,
+) (*ChangeListResponse, int, error) {
+	return c.getChangeList(ctx, "GetTVChangeList", GetTVChangeListURL, startDate, endDate, page)
+}
+
+// GetPersonChangeList fetches the list of people that have changed in the given window
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - startDate: the start date (optional)
+// - endDate: the end date (optional, at most 14 days after the start date)
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*ChangeListResponse): the response containing the list of changed people
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the changed people
+func (c Client) GetPersonChangeList(
+	ctx context.Context,
+	startDate Date,
+	endDate Date,
+	page int32,
+) (*ChangeListResponse, int, error) {
+	return c.getChangeList(ctx, "GetPersonChangeList", GetPersonChangeListURL, startDate, endDate, page)
+}
+
+// GetMovieChanges fetches the changes made to a given movie in the given window
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - movieID: the ID of the movie
+// - startDate: the start date (optional)
+// - endDate: the end date (optional, at most 14 days after the start date)
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*ChangesResponse): the response containing the changes of the movie
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the movie changes
+func (c Client) GetMovieChanges(
+	ctx context.Context,
+	movieID MovieID,
+	startDate Date,
+	endDate Date,
+	page int32,
+) (*ChangesResponse, int, error) {
+	apiURL := fmt.Sprintf(GetMovieChangesURL, fmt.Sprintf("%d", movieID))
+	return c.getChanges(ctx, "GetMovieChanges", apiURL, startDate, endDate, page)
+}
+
+// GetTVChanges fetches the changes made to a given TV show in the given window
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - seriesID: the ID of the TV show
+// - startDate: the start date (optional)
+// - endDate: the end date (optional, at most 14 days after the start date)
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*ChangesResponse): the response containing the changes of the TV show
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the TV show changes
+func (c Client) GetTVChanges(
+	ctx context.Context,
+	seriesID int32,
+	startDate Date,
+	endDate Date,
+	page int32,
+) (*ChangesResponse, int, error) {
+	apiURL := fmt.Sprintf(GetTVChangesURL, fmt.Sprintf("%d", seriesID))
+	return c.getChanges(ctx, "GetTVChanges", apiURL, startDate, endDate, page)
+}
+
+// GetTVSeasonChanges fetches the changes made to a given TV season in the given window
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - seasonID: the ID of the TV season
+// - startDate: the start date (optional)
+// - endDate: the end date (optional, at most 14 days after the start date)
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*ChangesResponse): the response containing the changes of the TV season
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the TV season changes
+func (c Client) GetTVSeasonChanges(
+	ctx context.Context,
+	seasonID int32,
+	startDate Date,
+	endDate Date,
+	page int32,
+) (*ChangesResponse, int, error) {
+	apiURL := fmt.Sprintf(GetTVSeasonChangesURL, fmt.Sprintf("%d", seasonID))
+	return c.getChanges(ctx, "GetTVSeasonChanges", apiURL, startDate, endDate, page)
+}
+
+// GetTVEpisodeChanges fetches the changes made to a given TV episode in the given window
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - episodeID: the ID of the TV episode
+// - startDate: the start date (optional)
+// - endDate: the end date (optional, at most 14 days after the start date)
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*ChangesResponse): the response containing the changes of the TV episode
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the TV episode changes
+func (c Client) GetTVEpisodeChanges(
+	ctx context.Context,
+	episodeID int32,
+	startDate Date,
+	endDate Date,
+	page int32,
+) (*ChangesResponse, int, error) {
+	apiURL := fmt.Sprintf(GetTVEpisodeChangesURL, fmt.Sprintf("%d", episodeID))
+	return c.getChanges(ctx, "GetTVEpisodeChanges", apiURL, startDate, endDate, page)
+}
+
+// GetPersonChanges fetches the changes made to a given person in the given window
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - personID: the ID of the person
+// - startDate: the start date (optional)
+// - endDate: the end date (optional, at most 14 days after the start date)
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*ChangesResponse): the response containing the changes of the person
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the person changes
+func (c Client) GetPersonChanges(
+	ctx context.Context,
+	personID PersonID,
+	startDate Date,
+	endDate Date,
+	page int32,
+) (*ChangesResponse, int, error) {
+	apiURL := fmt.Sprintf(GetPersonChangesURL, fmt.Sprintf("%d", personID))
+	return c.getChanges(ctx, "GetPersonChanges", apiURL, startDate, endDate, page)
+}
+
+// CreateRequestToken creates a new request token that must be approved by the user before creating a session
+//
+// Parameters:
+//
+// - ctx: the context of the request
+//
+// Returns:
+//
+// - (*RequestTokenResponse): the response containing the request token
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error creating the request token
+func (c Client) CreateRequestToken(
+	ctx context.Context,
+) (parsedResp *RequestTokenResponse, statusCode int, err error) {
+	// Create the HTTP request
+	req, err := buildRequest(ctx, "CreateRequestToken", http.MethodGet, CreateRequestTokenURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Make the HTTP request and parse the response
+	parsedResp = &RequestTokenResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// ValidateRequestTokenWithLogin approves a request token using the user's TMDB credentials
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - username: the TMDB username
+// - password: the TMDB password
+// - requestToken: the request token to approve
+//
+// Returns:
+//
+// - (*RequestTokenResponse): the response containing the approved request token
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error validating the request token
+func (c Client) ValidateRequestTokenWithLogin(
+	ctx context.Context,
+	username string,
+	password string,
+	requestToken string,
+) (parsedResp *RequestTokenResponse, statusCode int, err error) {
+	if requestToken == "" {
+		return nil, 0, ErrEmptyRequestToken
+	}
+
+	// Create the HTTP request
+	req, err := buildRequest(
+		ctx,
+		"ValidateRequestTokenWithLogin",
+		http.MethodPost,
+		ValidateRequestTokenWithLoginURL,
+		&ValidateRequestTokenWithLoginRequest{
+			Username:     username,
+			Password:     password,
+			RequestToken: requestToken,
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Make the HTTP request and parse the response
+	parsedResp = &RequestTokenResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// CreateSession creates a new user session from an approved request token
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - requestToken: the approved request token
+//
+// Returns:
+//
+// - *Session: the TMDB user session
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error creating the session
+func (c Client) CreateSession(
+	ctx context.Context,
+	requestToken string,
+) (session *Session, statusCode int, err error) {
+	if requestToken == "" {
+		return nil, 0, ErrEmptyRequestToken
+	}
+
+	// Create the HTTP request
+	req, err := buildRequest(
+		ctx,
+		"CreateSession",
+		http.MethodPost,
+		CreateSessionURL,
+		&CreateSessionRequest{RequestToken: requestToken},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Make the HTTP request and parse the response
+	var parsedResp SessionResponse
+	if statusCode, err = c.doRequest(req, &parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return NewSession(parsedResp.SessionID), statusCode, nil
+}
+
+// CreateSessionFromV4Token creates a new v3 user session from an approved v4 access token
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the v4 access token
+//
+// Returns:
+//
+// - *Session: the TMDB user session
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error creating the session
+func (c Client) CreateSessionFromV4Token(
+	ctx context.Context,
+	accessToken string,
+) (session *Session, statusCode int, err error) {
+	if accessToken == "" {
+		return nil, 0, ErrEmptyAccessToken
+	}
+
+	// Create the HTTP request
+	req, err := buildRequest(
+		ctx,
+		"CreateSessionFromV4Token",
+		http.MethodPost,
+		CreateSessionFromV4TokenURL,
+		&CreateSessionFromV4TokenRequest{AccessToken: accessToken},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Make the HTTP request and parse the response
+	var parsedResp SessionResponse
+	if statusCode, err = c.doRequest(req, &parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return NewSession(parsedResp.SessionID), statusCode, nil
+}
+
+// CreateGuestSession creates a new guest session, which can be used to rate items without a TMDB account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+//
+// Returns:
+//
+// - *Session: the TMDB guest session
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error creating the guest session
+func (c Client) CreateGuestSession(
+	ctx context.Context,
+) (session *Session, statusCode int, err error) {
+	// Create the HTTP request
+	req, err := buildRequest(ctx, "CreateGuestSession", http.MethodGet, CreateGuestSessionURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Make the HTTP request and parse the response
+	var parsedResp GuestSessionResponse
+	if statusCode, err = c.doRequest(req, &parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return NewGuestSession(parsedResp.GuestSessionID, parsedResp.ExpiresAt), statusCode, nil
+}
+
+// DeleteSession deletes a user session, logging the user out
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - session: the TMDB user session
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the deletion
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error deleting the session
+func (c Client) DeleteSession(
+	ctx context.Context,
+	session *Session,
+) (parsedResp *StatusResponse, statusCode int, err error) {
+	if err = validateUserSession(session); err != nil {
+		return nil, 0, err
+	}
+
+	// Create the HTTP request
+	req, err := buildRequest(
+		ctx,
+		"DeleteSession",
+		http.MethodDelete,
+		DeleteSessionURL,
+		&DeleteSessionRequest{SessionID: session.ID},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Make the HTTP request and parse the response
+	parsedResp = &StatusResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetAccountDetails fetches the details of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accountID: the ID of the account
+// - session: the TMDB user session
+//
+// Returns:
+//
+// - (*AccountDetailsResponse): the response containing the account details
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the account details
+func (c Client) GetAccountDetails(
+	ctx context.Context,
+	accountID int32,
+	session *Session,
+) (parsedResp *AccountDetailsResponse, statusCode int, err error) {
+	if err = validateUserSession(session); err != nil {
+		return nil, 0, err
+	}
+
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(GetAccountDetailsURL, fmt.Sprintf("%d", accountID))
+	req, err := buildRequest(ctx, "GetAccountDetails", http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Add query parameters
+	q := req.URL.Query()
+	AddSessionQueryParameter(q, session)
+	req.URL.RawQuery = q.Encode()
+
+	// Make the HTTP request and parse the response
+	parsedResp = &AccountDetailsResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// doSessionStatusRequest sends a write request authenticated with a session and parses the TMDB status response
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - endpoint: the endpoint name, used by the middlewares, hooks and logs
+// - method: the HTTP method
+// - apiURL: the TMDB API URL
+// - session: the TMDB user or guest session
+// - body: the value to be encoded as the JSON request body (optional)
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error making the request
+func (c Client) doSessionStatusRequest(
+	ctx context.Context,
+	endpoint string,
+	method string,
+	apiURL string,
+	session *Session,
+	body any,
+) (parsedResp *StatusResponse, statusCode int, err error) {
+	// Create the HTTP request
+	req, err := buildRequest(ctx, endpoint, method, apiURL, body)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Add query parameters
+	q := req.URL.Query()
+	AddSessionQueryParameter(q, session)
+	req.URL.RawQuery = q.Encode()
+
+	// Make the HTTP request and parse the response
+	parsedResp = &StatusResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// AddFavorite marks or unmarks a movie or TV show as favorite of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accountID: the ID of the account
+// - session: the TMDB user session
+// - mediaType: the media type of the item
+// - mediaID: the ID of the item
+// - favorite: whether to mark or unmark the item as favorite
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error marking the item as favorite
+func (c Client) AddFavorite(
+	ctx context.Context,
+	accountID int32,
+	session *Session,
+	mediaType MediaTypeEnum,
+	mediaID int32,
+	favorite bool,
+) (*StatusResponse, int, error) {
+	if err := validateUserSession(session); err != nil {
+		return nil, 0, err
+	}
+	if err := validateMediaType(mediaType); err != nil {
+		return nil, 0, err
+	}
+
+	apiURL := fmt.Sprintf(AddFavoriteURL, fmt.Sprintf("%d", accountID))
+	return c.doSessionStatusRequest(
+		ctx,
+		"AddFavorite",
+		http.MethodPost,
+		apiURL,
+		session,
+		&FavoriteRequest{
+			MediaType: mediaType,
+			MediaID:   mediaID,
+			Favorite:  favorite,
+		},
+	)
+}
+
+// AddToWatchlist adds or removes a movie or TV show from the watchlist of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accountID: the ID of the account
+// - session: the TMDB user session
+// - mediaType: the media type of the item
+// - mediaID: the ID of the item
+// - watchlist: whether to add or remove the item from the watchlist
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error adding the item to the watchlist
+func (c Client) AddToWatchlist(
+	ctx context.Context,
+	accountID int32,
+	session *Session,
+	mediaType MediaTypeEnum,
+	mediaID int32,
+	watchlist bool,
+) (*StatusResponse, int, error) {
+	if err := validateUserSession(session); err != nil {
+		return nil, 0, err
+	}
+	if err := validateMediaType(mediaType); err != nil {
+		return nil, 0, err
+	}
+
+	apiURL := fmt.Sprintf(AddToWatchlistURL, fmt.Sprintf("%d", accountID))
+	return c.doSessionStatusRequest(
+		ctx,
+		"AddToWatchlist",
+		http.MethodPost,
+		apiURL,
+		session,
+		&WatchlistRequest{
+			MediaType: mediaType,
+			MediaID:   mediaID,
+			Watchlist: watchlist,
+		},
+	)
+}
+
+// getAccountList fetches a paginated list of items of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - endpoint: the endpoint name, used by the middlewares, hooks and logs
+// - apiURL: the TMDB API URL
+// - session: the TMDB user session
+// - language: the language code (optional, defaults to "en-US")
+// - page: the page number (optional, defaults to 1)
+// - sortBy: the sort by value (optional)
+// - parsedResp: the pointer to the value where the response will be parsed into
+//
+// Returns:
+//
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the account list
+func (c Client) getAccountList(
+	ctx context.Context,
+	endpoint string,
+	apiURL string,
+	session *Session,
+	language string,
+	page int32,
+	sortBy AccountSortByEnum,
+	parsedResp any,
+) (int, error) {
+	if err := validateUserSession(session); err != nil {
+		return 0, err
+	}
+
+	// Create the HTTP request
+	req, err := buildRequest(ctx, endpoint, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	// Add query parameters
+	AddAccountListQueryParameters(req, session, language, page, sortBy)
+	c.addDefaultQueryParameters(req, Language)
+
+	// Make the HTTP request and parse the response
+	return c.doRequest(req, parsedResp)
+}
+
+// GetFavoriteMovies fetches the favorite movies of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accountID: the ID of the account
+// - session: the TMDB user session
+// - language: the language code (optional, defaults to "en-US")
+// - page: the page number (optional, defaults to 1)
+// - sortBy: the sort by value (optional)
+//
+// Returns:
+//
+// - (*MovieListResponse): the response containing the favorite movies
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the favorite movies
+func (c Client) GetFavoriteMovies(
+	ctx context.Context,
+	accountID int32,
+	session *Session,
+	language string,
+	page int32,
+	sortBy AccountSortByEnum,
+) (parsedResp *MovieListResponse, statusCode int, err error) {
+	apiURL := fmt.Sprintf(GetFavoriteMoviesURL, fmt.Sprintf("%d", accountID))
+	parsedResp = &MovieListResponse{}
+	if statusCode, err = c.getAccountList(
+		ctx,
+		"GetFavoriteMovies",
+		apiURL,
+		session,
+		language,
+		page,
+		sortBy,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetFavoriteTV fetches the favorite TV shows of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accountID: the ID of the account
+// - session: the TMDB user session
+// - language: the language code (optional, defaults to "en-US")
+// - page: the page number (optional, defaults to 1)
+// - sortBy: the sort by value (optional)
+//
+// Returns:
+//
+// - (*TVListResponse): the response containing the favorite TV shows
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the favorite TV shows
+func (c Client) GetFavoriteTV(
+	ctx context.Context,
+	accountID int32,
+	session *Session,
+	language string,
+	page int32,
+	sortBy AccountSortByEnum,
+) (parsedResp *TVListResponse, statusCode int, err error) {
+	apiURL := fmt.Sprintf(GetFavoriteTVURL, fmt.Sprintf("%d", accountID))
+	parsedResp = &TVListResponse{}
+	if statusCode, err = c.getAccountList(
+		ctx,
+		"GetFavoriteTV",
+		apiURL,
+		session,
+		language,
+		page,
+		sortBy,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetWatchlistMovies fetches the watchlist movies of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accountID: the ID of the account
+// - session: the TMDB user session
+// - language: the language code (optional, defaults to "en-US")
+// - page: the page number (optional, defaults to 1)
+// - sortBy: the sort by value (optional)
+//
+// Returns:
+//
+// - (*MovieListResponse): the response containing the watchlist movies
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the watchlist movies
+func (c Client) GetWatchlistMovies(
+	ctx context.Context,
+	accountID int32,
+	session *Session,
+	language string,
+	page int32,
+	sortBy AccountSortByEnum,
+) (parsedResp *MovieListResponse, statusCode int, err error) {
+	apiURL := fmt.Sprintf(GetWatchlistMoviesURL, fmt.Sprintf("%d", accountID))
+	parsedResp = &MovieListResponse{}
+	if statusCode, err = c.getAccountList(
+		ctx,
+		"GetWatchlistMovies",
+		apiURL,
+		session,
+		language,
+		page,
+		sortBy,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetWatchlistTV fetches the watchlist TV shows of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accountID: the ID of the account
+// - session: the TMDB user session
+// - language: the language code (optional, defaults to "en-US")
+// - page: the page number (optional, defaults to 1)
+// - sortBy: the sort by value (optional)
+//
+// Returns:
+//
+// - (*TVListResponse): the response containing the watchlist TV shows
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the watchlist TV shows
+func (c Client) GetWatchlistTV(
+	ctx context.Context,
+	accountID int32,
+	session *Session,
+	language string,
+	page int32,
+	sortBy AccountSortByEnum,
+) (parsedResp *TVListResponse, statusCode int, err error) {
+	apiURL := fmt.Sprintf(GetWatchlistTVURL, fmt.Sprintf("%d", accountID))
+	parsedResp = &TVListResponse{}
+	if statusCode, err = c.getAccountList(
+		ctx,
+		"GetWatchlistTV",
+		apiURL,
+		session,
+		language,
+		page,
+		sortBy,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetRatedMovies fetches the rated movies of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accountID: the ID of the account
+// - session: the TMDB user session
+// - language: the language code (optional, defaults to "en-US")
+// - page: the page number (optional, defaults to 1)
+// - sortBy: the sort by value (optional)
+//
+// Returns:
+//
+// - (*RatedMovieListResponse): the response containing the rated movies
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the rated movies
+func (c Client) GetRatedMovies(
+	ctx context.Context,
+	accountID int32,
+	session *Session,
+	language string,
+	page int32,
+	sortBy AccountSortByEnum,
+) (parsedResp *RatedMovieListResponse, statusCode int, err error) {
+	apiURL := fmt.Sprintf(GetRatedMoviesURL, fmt.Sprintf("%d", accountID))
+	parsedResp = &RatedMovieListResponse{}
+	if statusCode, err = c.getAccountList(
+		ctx,
+		"GetRatedMovies",
+		apiURL,
+		session,
+		language,
+		page,
+		sortBy,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetRatedTV fetches the rated TV shows of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accountID: the ID of the account
+// - session: the TMDB user session
+// - language: the language code (optional, defaults to "en-US")
+// - page: the page number (optional, defaults to 1)
+// - sortBy: the sort by value (optional)
+//
+// Returns:
+//
+// - (*RatedTVListResponse): the response containing the rated TV shows
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the rated TV shows
+func (c Client) GetRatedTV(
+	ctx context.Context,
+	accountID int32,
+	session *Session,
+	language string,
+	page int32,
+	sortBy AccountSortByEnum,
+) (parsedResp *RatedTVListResponse, statusCode int, err error) {
+	apiURL := fmt.Sprintf(GetRatedTVURL, fmt.Sprintf("%d", accountID))
+	parsedResp = &RatedTVListResponse{}
+	if statusCode, err = c.getAccountList(
+		ctx,
+		"GetRatedTV",
+		apiURL,
+		session,
+		language,
+		page,
+		sortBy,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetRatedTVEpisodes fetches the rated TV episodes of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accountID: the ID of the account
+// - session: the TMDB user session
+// - language: the language code (optional, defaults to "en-US")
+// - page: the page number (optional, defaults to 1)
+// - sortBy: the sort by value (optional)
+//
+// Returns:
+//
+// - (*RatedTVEpisodeListResponse): the response containing the rated TV episodes
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the rated TV episodes
+func (c Client) GetRatedTVEpisodes(
+	ctx context.Context,
+	accountID int32,
+	session *Session,
+	language string,
+	page int32,
+	sortBy AccountSortByEnum,
+) (parsedResp *RatedTVEpisodeListResponse, statusCode int, err error) {
+	apiURL := fmt.Sprintf(GetRatedTVEpisodesURL, fmt.Sprintf("%d", accountID))
+	parsedResp = &RatedTVEpisodeListResponse{}
+	if statusCode, err = c.getAccountList(
+		ctx,
+		"GetRatedTVEpisodes",
+		apiURL,
+		session,
+		language,
+		page,
+		sortBy,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetMovieAccountStates fetches the rating, favorite and watchlist states of a given movie for a session
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - movieID: the ID of the movie
+// - session: the TMDB user or guest session
+//
+// Returns:
+//
+// - (*MovieAccountStatesResponse): the response containing the movie account states
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the movie account states
+func (c Client) GetMovieAccountStates(
+	ctx context.Context,
+	movieID MovieID,
+	session *Session,
+) (parsedResp *MovieAccountStatesResponse, statusCode int, err error) {
+	if err = validateSession(session); err != nil {
+		return nil, 0, err
+	}
+
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(GetMovieAccountStatesURL, fmt.Sprintf("%d", movieID))
+	req, err := buildRequest(ctx, "GetMovieAccountStates", http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Add query parameters
+	q := req.URL.Query()
+	AddSessionQueryParameter(q, session)
+	req.URL.RawQuery = q.Encode()
+
+	// Make the HTTP request and parse the response
+	parsedResp = &MovieAccountStatesResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// rate adds or deletes the rating of an item authenticated with a user or guest session
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - endpoint: the endpoint name, used by the middlewares, hooks and logs
+// - apiURL: the TMDB API URL
+// - session: the TMDB user or guest session
+// - value: the rating value, or nil to delete the rating
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error rating the item
+func (c Client) rate(
+	ctx context.Context,
+	endpoint string,
+	apiURL string,
+	session *Session,
+	value *float32,
+) (*StatusResponse, int, error) {
+	if err := validateSession(session); err != nil {
+		return nil, 0, err
+	}
+
+	// Delete the rating if no value was given
+	if value == nil {
+		return c.doSessionStatusRequest(ctx, endpoint, http.MethodDelete, apiURL, session, nil)
+	}
+
+	// Validate the rating value
+	if err := ValidateRating(*value); err != nil {
+		return nil, 0, err
+	}
+	return c.doSessionStatusRequest(
+		ctx,
+		endpoint,
+		http.MethodPost,
+		apiURL,
+		session,
+		&RatingRequest{Value: *value},
+	)
+}
+
+// RateMovie rates a given movie, authenticated with either a user or a guest session
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - movieID: the ID of the movie
+// - session: the TMDB user or guest session
+// - value: the rating value, from 0.5 to 10.0 in steps of 0.5
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error rating the item or the rating value is not valid
+func (c Client) RateMovie(
+	ctx context.Context,
+	movieID MovieID,
+	session *Session,
+	value float32,
+) (*StatusResponse, int, error) {
+	apiURL := fmt.Sprintf(MovieRatingURL, fmt.Sprintf("%d", movieID))
+	return c.rate(ctx, "RateMovie", apiURL, session, &value)
+}
+
+// DeleteMovieRating deletes the rating of a given movie, authenticated with either a user or a guest session
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - movieID: the ID of the movie
+// - session: the TMDB user or guest session
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error deleting the rating
+func (c Client) DeleteMovieRating(
+	ctx context.Context,
+	movieID MovieID,
+	session *Session,
+) (*StatusResponse, int, error) {
+	apiURL := fmt.Sprintf(MovieRatingURL, fmt.Sprintf("%d", movieID))
+	return c.rate(ctx, "DeleteMovieRating", apiURL, session, nil)
+}
+
+// RateTV rates a given TV show, authenticated with either a user or a guest session
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - seriesID: the ID of the TV show
+// - session: the TMDB user or guest session
+// - value: the rating value, from 0.5 to 10.0 in steps of 0.5
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error rating the item or the rating value is not valid
+func (c Client) RateTV(
+	ctx context.Context,
+	seriesID int32,
+	session *Session,
+	value float32,
+) (*StatusResponse, int, error) {
+	apiURL := fmt.Sprintf(TVRatingURL, fmt.Sprintf("%d", seriesID))
+	return c.rate(ctx, "RateTV", apiURL, session, &value)
+}
+
+// DeleteTVRating deletes the rating of a given TV show, authenticated with either a user or a guest session
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - seriesID: the ID of the TV show
+// - session: the TMDB user or guest session
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error deleting the rating
+func (c Client) DeleteTVRating(
+	ctx context.Context,
+	seriesID int32,
+	session *Session,
+) (*StatusResponse, int, error) {
+	apiURL := fmt.Sprintf(TVRatingURL, fmt.Sprintf("%d", seriesID))
+	return c.rate(ctx, "DeleteTVRating", apiURL, session, nil)
+}
+
+// RateTVEpisode rates a given TV episode, authenticated with either a user or a guest session
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - seriesID: the ID of the TV show
+// - seasonNumber: the season number
+// - episodeNumber: the episode number
+// - session: the TMDB user or guest session
+// - value: the rating value, from 0.5 to 10.0 in steps of 0.5
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error rating the item or the rating value is not valid
+func (c Client) RateTVEpisode(
+	ctx context.Context,
+	seriesID int32,
+	seasonNumber int32,
+	episodeNumber int32,
+	session *Session,
+	value float32,
+) (*StatusResponse, int, error) {
+	apiURL := fmt.Sprintf(
+		TVEpisodeRatingURL,
+		fmt.Sprintf("%d", seriesID),
+		fmt.Sprintf("%d", seasonNumber),
+		fmt.Sprintf("%d", episodeNumber),
+	)
+	return c.rate(ctx, "RateTVEpisode", apiURL, session, &value)
+}
+
+// DeleteTVEpisodeRating deletes the rating of a given TV episode, authenticated with either a user or a guest session
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - seriesID: the ID of the TV show
+// - seasonNumber: the season number
+// - episodeNumber: the episode number
+// - session: the TMDB user or guest session
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error deleting the rating
+func (c Client) DeleteTVEpisodeRating(
+	ctx context.Context,
+	seriesID int32,
+	seasonNumber int32,
+	episodeNumber int32,
+	session *Session,
+) (*StatusResponse, int, error) {
+	apiURL := fmt.Sprintf(
+		TVEpisodeRatingURL,
+		fmt.Sprintf("%d", seriesID),
+		fmt.Sprintf("%d", seasonNumber),
+		fmt.Sprintf("%d", episodeNumber),
+	)
+	return c.rate(ctx, "DeleteTVEpisodeRating", apiURL, session, nil)
+}
+
+// CreateList creates a new list for the user of a given session
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - session: the TMDB user session
+// - name: the name of the list
+// - description: the description of the list (optional)
+// - language: the language code of the list (optional)
+//
+// Returns:
+//
+// - (*CreateListResponse): the response containing the ID of the created list
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error creating the list
+func (c Client) CreateList(
+	ctx context.Context,
+	session *Session,
+	name string,
+	description string,
+	language string,
+) (parsedResp *CreateListResponse, statusCode int, err error) {
+	if err = validateUserSession(session); err != nil {
+		return nil, 0, err
+	}
+	if name == "" {
+		return nil, 0, ErrEmptyListName
+	}
+
+	// Create the HTTP request
+	req, err := buildRequest(
+		ctx,
+		"CreateList",
+		http.MethodPost,
+		CreateListURL,
+		&CreateListRequest{
+			Name:        name,
+			Description: description,
+			Language:    language,
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Add query parameters
+	q := req.URL.Query()
+	AddSessionQueryParameter(q, session)
+	req.URL.RawQuery = q.Encode()
+
+	// Make the HTTP request and parse the response
+	parsedResp = &CreateListResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetListDetails fetches the details and the paginated items of a given list
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - listID: the ID of the list
+// - language: the language code (optional, defaults to "en-US")
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*ListDetailsResponse): the response containing the list details
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the list details
+func (c Client) GetListDetails(
+	ctx context.Context,
+	listID string,
+	language string,
+	page int32,
+) (parsedResp *ListDetailsResponse, statusCode int, err error) {
+	if listID == "" {
+		return nil, 0, ErrEmptyListID
+	}
+
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(ListURL, url.PathEscape(listID))
+	req, err := buildRequest(ctx, "GetListDetails", http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Add query parameters
+	q := req.URL.Query()
+	AddLanguageQueryParameter(q, language)
+	AddPageQueryParameter(q, page)
+	req.URL.RawQuery = q.Encode()
+	c.addDefaultQueryParameters(req, Language)
+
+	// Make the HTTP request and parse the response
+	parsedResp = &ListDetailsResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// AddMovieToList adds a movie to a given list
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - listID: the ID of the list
+// - session: the TMDB user session
+// - movieID: the ID of the movie
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error adding the movie to the list
+func (c Client) AddMovieToList(
+	ctx context.Context,
+	listID string,
+	session *Session,
+	movieID MovieID,
+) (*StatusResponse, int, error) {
+	if err := validateUserSession(session); err != nil {
+		return nil, 0, err
+	}
+	if listID == "" {
+		return nil, 0, ErrEmptyListID
+	}
+
+	apiURL := fmt.Sprintf(AddMovieToListURL, url.PathEscape(listID))
+	return c.doSessionStatusRequest(
+		ctx,
+		"AddMovieToList",
+		http.MethodPost,
+		apiURL,
+		session,
+		&ListItemRequest{MediaID: movieID},
+	)
+}
+
+// RemoveMovieFromList removes a movie from a given list
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - listID: the ID of the list
+// - session: the TMDB user session
+// - movieID: the ID of the movie
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error removing the movie from the list
+func (c Client) RemoveMovieFromList(
+	ctx context.Context,
+	listID string,
+	session *Session,
+	movieID MovieID,
+) (*StatusResponse, int, error) {
+	if err := validateUserSession(session); err != nil {
+		return nil, 0, err
+	}
+	if listID == "" {
+		return nil, 0, ErrEmptyListID
+	}
+
+	apiURL := fmt.Sprintf(RemoveMovieFromListURL, url.PathEscape(listID))
+	return c.doSessionStatusRequest(
+		ctx,
+		"RemoveMovieFromList",
+		http.MethodPost,
+		apiURL,
+		session,
+		&ListItemRequest{MediaID: movieID},
+	)
+}
+
+// CheckItemStatus checks if a movie is present in a given list
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - listID: the ID of the list
+// - movieID: the ID of the movie
+//
+// Returns:
+//
+// - (*ListItemStatusResponse): the response containing whether the movie is present in the list
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error checking the item status
+func (c Client) CheckItemStatus(
+	ctx context.Context,
+	listID string,
+	movieID MovieID,
+) (parsedResp *ListItemStatusResponse, statusCode int, err error) {
+	if listID == "" {
+		return nil, 0, ErrEmptyListID
+	}
+
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(CheckItemStatusURL, url.PathEscape(listID))
+	req, err := buildRequest(ctx, "CheckItemStatus", http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Add query parameters
+	q := req.URL.Query()
+	q.Add(ItemMovieID, movieID.String())
+	req.URL.RawQuery = q.Encode()
+
+	// Make the HTTP request and parse the response
+	parsedResp = &ListItemStatusResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// ClearList removes all the items from a given list
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - listID: the ID of the list
+// - session: the TMDB user session
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error clearing the list
+func (c Client) ClearList(
+	ctx context.Context,
+	listID string,
+	session *Session,
+) (*StatusResponse, int, error) {
+	if err := validateUserSession(session); err != nil {
+		return nil, 0, err
+	}
+	if listID == "" {
+		return nil, 0, ErrEmptyListID
+	}
+
+	apiURL := fmt.Sprintf(ClearListURL, url.PathEscape(listID)) + "?" + Confirm + "=true"
+	return c.doSessionStatusRequest(ctx, "ClearList", http.MethodPost, apiURL, session, nil)
+}
+
+// DeleteList deletes a given list
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - listID: the ID of the list
+// - session: the TMDB user session
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error deleting the list
+func (c Client) DeleteList(
+	ctx context.Context,
+	listID string,
+	session *Session,
+) (*StatusResponse, int, error) {
+	if err := validateUserSession(session); err != nil {
+		return nil, 0, err
+	}
+	if listID == "" {
+		return nil, 0, ErrEmptyListID
+	}
+
+	apiURL := fmt.Sprintf(ListURL, url.PathEscape(listID))
+	return c.doSessionStatusRequest(ctx, "DeleteList", http.MethodDelete, apiURL, session, nil)
+}
+
+// GetReviewDetails fetches the details of a given review with the movie or TV show it belongs to
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - reviewID: the ID of the review
+//
+// Returns:
+//
+// - (*ReviewDetailsResponse): the response containing the review details
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the review details
+func (c Client) GetReviewDetails(
+	ctx context.Context,
+	reviewID string,
+) (parsedResp *ReviewDetailsResponse, statusCode int, err error) {
+	if reviewID == "" {
+		return nil, 0, ErrEmptyReviewID
+	}
+
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(GetReviewDetailsURL, url.PathEscape(reviewID))
+	req, err := buildRequest(ctx, "GetReviewDetails", http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Make the HTTP request and parse the response
+	parsedResp = &ReviewDetailsResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetCreditDetails fetches the details of a given credit, including its media, person and TV episodes or seasons
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - creditID: the ID of the credit
+//
+// Returns:
+//
+// - (*CreditDetailsResponse): the response containing the credit details
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the credit details
+func (c Client) GetCreditDetails(
+	ctx context.Context,
+	creditID string,
+) (parsedResp *CreditDetailsResponse, statusCode int, err error) {
+	if creditID == "" {
+		return nil, 0, ErrEmptyCreditID
+	}
+
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(GetCreditDetailsURL, url.PathEscape(creditID))
+	req, err := buildRequest(ctx, "GetCreditDetails", http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Make the HTTP request and parse the response
+	parsedResp = &CreditDetailsResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetTVEpisodeGroups fetches the episode groups of a given TV show, such as alternative orderings
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - seriesID: the ID of the TV show
+//
+// Returns:
+//
+// - (*TVEpisodeGroupsResponse): the response containing the episode groups
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the episode groups
+func (c Client) GetTVEpisodeGroups(
+	ctx context.Context,
+	seriesID int32,
+) (parsedResp *TVEpisodeGroupsResponse, statusCode int, err error) {
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(GetTVEpisodeGroupsURL, fmt.Sprintf("%d", seriesID))
+	req, err := buildRequest(ctx, "GetTVEpisodeGroups", http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Make the HTTP request and parse the response
+	parsedResp = &TVEpisodeGroupsResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetTVEpisodeGroupDetails fetches the details of a given episode group, including its groups and episodes
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - episodeGroupID: the ID of the episode group
+//
+// Returns:
+//
+// - (*TVEpisodeGroupDetailsResponse): the response containing the episode group details
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the episode group details
+func (c Client) GetTVEpisodeGroupDetails(
+	ctx context.Context,
+	episodeGroupID string,
+) (parsedResp *TVEpisodeGroupDetailsResponse, statusCode int, err error) {
+	if episodeGroupID == "" {
+		return nil, 0, ErrEmptyEpisodeGroupID
+	}
+
+	// Create the HTTP request
+	apiURL := fmt.Sprintf(GetTVEpisodeGroupDetailsURL, url.PathEscape(episodeGroupID))
+	req, err := buildRequest(ctx, "GetTVEpisodeGroupDetails", http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Make the HTTP request and parse the response
+	parsedResp = &TVEpisodeGroupDetailsResponse{}
+	if statusCode, err = c.doRequest(req, parsedResp); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// getTVSeason fetches a resource of a given TV season
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - endpoint: the endpoint name, used by the middlewares, hooks and logs
+// - apiURL: the TMDB API URL format, with placeholders for the TV show ID and the season number
+// - seriesID: the ID of the TV show
+// - seasonNumber: the season number
+// - query: the query parameters, or nil if the endpoint does not accept any (optional)
+// - parsedResp: the pointer to the value where the response will be parsed into
+//
+// Returns:
+//
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the TV season resource
+func (c Client) getTVSeason(
+	ctx context.Context,
+	endpoint string,
+	apiURL string,
+	seriesID int32,
+	seasonNumber int32,
+	query url.Values,
+	parsedResp any,
+) (int, error) {
+	// Create the HTTP request
+	req, err := buildRequest(
+		ctx,
+		endpoint,
+		http.MethodGet,
+		fmt.Sprintf(apiURL, fmt.Sprintf("%d", seriesID), fmt.Sprintf("%d", seasonNumber)),
+		nil,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// Add query parameters, including the default language for the endpoints that accept them
+	if query != nil {
+		mergeQueryParameters(req, query)
+		c.addDefaultQueryParameters(req, Language)
+	}
+
+	// Make the HTTP request and parse the response
+	return c.doRequest(req, parsedResp)
+}
+
+// GetTVSeasonAggregateCredits fetches the cast and crew of a given TV season, aggregated across its episodes
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - seriesID: the ID of the TV show
+// - seasonNumber: the season number
+// - language: the language code (optional, defaults to "en-US")
+//
+// Returns:
+//
+// - (*AggregateCreditsResponse): the response containing the aggregate credits
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the aggregate credits
+func (c Client) GetTVSeasonAggregateCredits(
+	ctx context.Context,
+	seriesID int32,
+	seasonNumber int32,
+	language string,
+) (parsedResp *AggregateCreditsResponse, statusCode int, err error) {
+	// Add query parameters
+	q := url.Values{}
+	AddLanguageQueryParameter(q, language)
+
+	parsedResp = &AggregateCreditsResponse{}
+	if statusCode, err = c.getTVSeason(
+		ctx,
+		"GetTVSeasonAggregateCredits",
+		GetTVSeasonAggregateCreditsURL,
+		seriesID,
+		seasonNumber,
+		q,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetTVSeasonImages fetches the posters of a given TV season
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - seriesID: the ID of the TV show
+// - seasonNumber: the season number
+// - language: the language code (optional, defaults to "en-US")
+// - includeImageLanguage: the list of language codes of the images to include (optional)
+//
+// Returns:
+//
+// - (*PosterImagesResponse): the response containing the TV season posters
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the TV season images
+func (c Client) GetTVSeasonImages(
+	ctx context.Context,
+	seriesID int32,
+	seasonNumber int32,
+	language string,
+	includeImageLanguage []string,
+) (parsedResp *PosterImagesResponse, statusCode int, err error) {
+	// Add query parameters
+	q := url.Values{}
+	AddLanguageQueryParameter(q, language)
+	AddIncludeImageLanguageQueryParameter(q, includeImageLanguage)
+
+	parsedResp = &PosterImagesResponse{}
+	if statusCode, err = c.getTVSeason(
+		ctx,
+		"GetTVSeasonImages",
+		GetTVSeasonImagesURL,
+		seriesID,
+		seasonNumber,
+		q,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetTVSeasonVideos fetches the videos of a given TV season
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - seriesID: the ID of the TV show
+// - seasonNumber: the season number
+// - language: the language code (optional, defaults to "en-US")
+// - includeVideoLanguage: the list of language codes of the videos to include (optional)
+//
+// Returns:
+//
+// - (*VideosResponse): the response containing the TV season videos
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the TV season videos
+func (c Client) GetTVSeasonVideos(
+	ctx context.Context,
+	seriesID int32,
+	seasonNumber int32,
+	language string,
+	includeVideoLanguage []string,
+) (parsedResp *VideosResponse, statusCode int, err error) {
+	// Add query parameters
+	q := url.Values{}
+	AddLanguageQueryParameter(q, language)
+	AddIncludeVideoLanguageQueryParameter(q, includeVideoLanguage)
+
+	parsedResp = &VideosResponse{}
+	if statusCode, err = c.getTVSeason(
+		ctx,
+		"GetTVSeasonVideos",
+		GetTVSeasonVideosURL,
+		seriesID,
+		seasonNumber,
+		q,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetTVSeasonWatchProviders fetches the watch providers of a given TV season, keyed by country code
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - seriesID: the ID of the TV show
+// - seasonNumber: the season number
+// - language: the language code (optional, defaults to "en-US")
+//
+// Returns:
+//
+// - (*WatchProvidersResponse): the response containing the TV season watch providers
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the TV season watch providers
+func (c Client) GetTVSeasonWatchProviders(
+	ctx context.Context,
+	seriesID int32,
+	seasonNumber int32,
+	language string,
+) (parsedResp *WatchProvidersResponse, statusCode int, err error) {
+	// Add query parameters
+	q := url.Values{}
+	AddLanguageQueryParameter(q, language)
+
+	parsedResp = &WatchProvidersResponse{}
+	if statusCode, err = c.getTVSeason(
+		ctx,
+		"GetTVSeasonWatchProviders",
+		GetTVSeasonWatchProvidersURL,
+		seriesID,
+		seasonNumber,
+		q,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetTVSeasonExternalIDs fetches the external IDs of a given TV season, such as its TVDB ID
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - seriesID: the ID of the TV show
+// - seasonNumber: the season number
+//
+// Returns:
+//
+// - (*TVSeasonExternalIDsResponse): the response containing the TV season external IDs
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the TV season external IDs
+func (c Client) GetTVSeasonExternalIDs(
+	ctx context.Context,
+	seriesID int32,
+	seasonNumber int32,
+) (parsedResp *TVSeasonExternalIDsResponse, statusCode int, err error) {
+	parsedResp = &TVSeasonExternalIDsResponse{}
+	if statusCode, err = c.getTVSeason(
+		ctx,
+		"GetTVSeasonExternalIDs",
+		GetTVSeasonExternalIDsURL,
+		seriesID,
+		seasonNumber,
+		nil,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
 	}
-	return parsedResp, resp.StatusCode, nil
+	return parsedResp, statusCode, nil
 }
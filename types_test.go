@@ -2,8 +2,11 @@ package gotmdbapi
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 )
 
 // CreateClient creates a new TMDB API client using the TMDB_TOKEN environment variable
@@ -73,3 +76,293 @@ func TestGetMovieDetailsEndpoint(t *testing.T) {
 
 	t.Logf("GetMovieDetails returned movie: %s", response.Title)
 }
+
+// TestGetCompanyDetailsEndpoint tests the GetCompanyDetails endpoint of the TMDB API client
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestGetCompanyDetailsEndpoint(t *testing.T) {
+	// Create the TMDB API client
+	client, err := CreateClient()
+	if err != nil {
+		t.Fatalf("Failed to create TMDB API client: %v", err)
+	}
+
+	// Call the GetCompanyDetails method for a known company ID (e.g., 420 for Marvel Studios)
+	response, statusCode, err := client.GetCompanyDetails(context.Background(), 420)
+	if err != nil {
+		t.Fatalf("GetCompanyDetails failed with status code %d: %v", statusCode, err)
+	}
+
+	// Check if the response is not nil
+	if response == nil {
+		t.Fatal("GetCompanyDetails returned nil response")
+	}
+
+	t.Logf("GetCompanyDetails returned company: %s", response.Name)
+}
+
+// TestSessionRequests tests that the authentication requests encode their JSON bodies and fill the sessions from the
+// responses
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestSessionRequests(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		response string
+		call     func(client *Client) (*Session, error)
+		method   string
+		url      string
+		body     string
+		expected *Session
+	}{
+		{
+			name:     "create request token",
+			response: `{"success":true,"expires_at":"2026-10-19 01:00:00 UTC","request_token":"request-token"}`,
+			call: func(client *Client) (*Session, error) {
+				response, _, err := client.CreateRequestToken(ctx)
+				if err == nil && response.RequestToken != "request-token" {
+					t.Errorf("CreateRequestToken() returned %+v", response)
+				}
+				return nil, err
+			},
+			method: http.MethodGet,
+			url:    CreateRequestTokenURL,
+		},
+		{
+			name:     "validate request token with login",
+			response: `{"success":true,"expires_at":"2026-10-19 01:00:00 UTC","request_token":"approved-token"}`,
+			call: func(client *Client) (*Session, error) {
+				response, _, err := client.ValidateRequestTokenWithLogin(ctx, "user", "secret", "request-token")
+				if err == nil && response.RequestToken != "approved-token" {
+					t.Errorf("ValidateRequestTokenWithLogin() returned %+v", response)
+				}
+				return nil, err
+			},
+			method: http.MethodPost,
+			url:    ValidateRequestTokenWithLoginURL,
+			body:   `{"username":"user","password":"secret","request_token":"request-token"}`,
+		},
+		{
+			name:     "create session",
+			response: `{"success":true,"session_id":"session-id"}`,
+			call: func(client *Client) (*Session, error) {
+				session, _, err := client.CreateSession(ctx, "approved-token")
+				return session, err
+			},
+			method:   http.MethodPost,
+			url:      CreateSessionURL,
+			body:     `{"request_token":"approved-token"}`,
+			expected: &Session{ID: "session-id"},
+		},
+		{
+			name:     "create session from v4 token",
+			response: `{"success":true,"session_id":"session-id"}`,
+			call: func(client *Client) (*Session, error) {
+				session, _, err := client.CreateSessionFromV4Token(ctx, "user-token")
+				return session, err
+			},
+			method:   http.MethodPost,
+			url:      CreateSessionFromV4TokenURL,
+			body:     `{"access_token":"user-token"}`,
+			expected: &Session{ID: "session-id"},
+		},
+		{
+			name:     "create guest session",
+			response: `{"success":true,"guest_session_id":"guest-id","expires_at":"2026-10-20 00:00:00 UTC"}`,
+			call: func(client *Client) (*Session, error) {
+				session, _, err := client.CreateGuestSession(ctx)
+				return session, err
+			},
+			method:   http.MethodGet,
+			url:      CreateGuestSessionURL,
+			expected: &Session{ID: "guest-id", Guest: true, ExpiresAt: "2026-10-20 00:00:00 UTC"},
+		},
+		{
+			name:     "delete session",
+			response: `{"success":true}`,
+			call: func(client *Client) (*Session, error) {
+				_, _, err := client.DeleteSession(ctx, NewSession("session-id"))
+				return nil, err
+			},
+			method: http.MethodDelete,
+			url:    DeleteSessionURL,
+			body:   `{"session_id":"session-id"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client, requests := newStubbedClient(t, "api-key", http.StatusOK, tt.response)
+				session, err := tt.call(client)
+				if err != nil {
+					t.Fatalf("call failed: %v", err)
+				}
+
+				req := (*requests)[0]
+				if req.Method != tt.method || req.URL.String() != tt.url {
+					t.Errorf("request is %s %s, expected %s %s", req.Method, req.URL, tt.method, tt.url)
+				}
+				if req.Body != tt.body {
+					t.Errorf("body is %q, expected %q", req.Body, tt.body)
+				}
+				if tt.body != "" && req.Header.Get("Content-Type") != "application/json;charset=utf-8" {
+					t.Errorf("Content-Type is %q, expected JSON", req.Header.Get("Content-Type"))
+				}
+				if tt.expected != nil && (session == nil || *session != *tt.expected) {
+					t.Errorf("session is %+v, expected %+v", session, tt.expected)
+				}
+			},
+		)
+	}
+}
+
+// TestListRequests tests that the list requests merge their query parameters with the session ID and API key
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestListRequests(t *testing.T) {
+	ctx := context.Background()
+	session := NewSession("session-id")
+	tests := []struct {
+		name  string
+		call  func(client *Client) error
+		path  string
+		query string
+		body  string
+	}{
+		{
+			name: "clear list",
+			call: func(client *Client) error {
+				_, _, err := client.ClearList(ctx, "list 1", session)
+				return err
+			},
+			path:  "/3/list/list%201/clear",
+			query: "api_key=" + testV3APIKey + "&confirm=true&session_id=session-id",
+		},
+		{
+			name: "add movie to list",
+			call: func(client *Client) error {
+				_, _, err := client.AddMovieToList(ctx, "list 1", session, 550)
+				return err
+			},
+			path:  "/3/list/list%201/add_item",
+			query: "api_key=" + testV3APIKey + "&session_id=session-id",
+			body:  `{"media_id":550}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client, requests := newStubbedClient(
+					t,
+					testV3APIKey,
+					http.StatusCreated,
+					`{"success":true,"status_code":12}`,
+				)
+				if err := tt.call(client); err != nil {
+					t.Fatalf("call failed: %v", err)
+				}
+
+				req := (*requests)[0]
+				if req.Method != http.MethodPost || req.URL.EscapedPath() != tt.path {
+					t.Errorf("request is %s %s, expected %s %s", req.Method, req.URL.EscapedPath(), http.MethodPost, tt.path)
+				}
+				if req.URL.RawQuery != tt.query {
+					t.Errorf("query is %q, expected %q", req.URL.RawQuery, tt.query)
+				}
+				if req.Body != tt.body {
+					t.Errorf("body is %q, expected %q", req.Body, tt.body)
+				}
+			},
+		)
+	}
+}
+
+// TestGetReviewDetails tests that the review ID is escaped in the path and the media type is decoded
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestGetReviewDetails(t *testing.T) {
+	client, requests := newStubbedClient(
+		t,
+		"api-key",
+		http.StatusOK,
+		`{"id":"58aa82f09251416f92006a3a","author":"Brett","iso_639_1":"en","media_id":550,`+
+			`"media_title":"Fight Club","media_type":"movie"}`,
+	)
+	response, _, err := client.GetReviewDetails(context.Background(), "review/1 a")
+	if err != nil {
+		t.Fatalf("GetReviewDetails() failed: %v", err)
+	}
+
+	if got := (*requests)[0].URL.EscapedPath(); got != "/3/review/review%2F1%20a" {
+		t.Errorf("path is %q, expected the review ID to be escaped", got)
+	}
+	if response.MediaType != MediaTypeMovie || response.MediaID != 550 || response.Author != "Brett" {
+		t.Errorf("GetReviewDetails() returned %+v", response)
+	}
+
+	// Check the empty review ID is rejected before making the request
+	if _, statusCode, err := client.GetReviewDetails(context.Background(), ""); !errors.Is(err, ErrEmptyReviewID) ||
+		statusCode != 0 {
+		t.Errorf("GetReviewDetails() returned status code %d and %v, expected 0 and %v", statusCode, err, ErrEmptyReviewID)
+	}
+	if len(*requests) != 1 {
+		t.Errorf("made %d requests, expected 1", len(*requests))
+	}
+}
+
+// TestGetCreditDetails tests that the credit ID is escaped in the path and the TV credits are decoded with their
+// episodes and seasons
+//
+// Parameters:
+//
+// - t: the testing.T instance
+func TestGetCreditDetails(t *testing.T) {
+	client, requests := newStubbedClient(
+		t,
+		"api-key",
+		http.StatusOK,
+		`{"credit_type":"cast","department":"Actors","id":"52542282760ee313280017f9","job":"Actor",`+
+			`"media_type":"tv","media":{"id":1396,"name":"Breaking Bad","media_type":"tv",`+
+			`"character":"Walter White","first_air_date":"2008-01-20",`+
+			`"episodes":[{"id":62085,"air_date":"2008-01-20","episode_number":1,"season_number":1,"show_id":1396,`+
+			`"name":"Pilot"}],`+
+			`"seasons":[{"id":3572,"air_date":"2008-01-20","episode_count":7,"season_number":1,"show_id":1396,`+
+			`"name":"Season 1"}]},`+
+			`"person":{"id":17419,"name":"Bryan Cranston","gender":2}}`,
+	)
+	response, _, err := client.GetCreditDetails(context.Background(), "credit?1")
+	if err != nil {
+		t.Fatalf("GetCreditDetails() failed: %v", err)
+	}
+
+	req := (*requests)[0]
+	if got := req.URL.EscapedPath(); got != "/3/credit/credit%3F1" || req.URL.RawQuery != "" {
+		t.Errorf("URL is %q, expected the credit ID to be escaped in the path", req.URL)
+	}
+	if response.MediaType != MediaTypeTV || response.Media.MediaType != MediaTypeTV {
+		t.Errorf("media types are %q and %q, expected %q", response.MediaType, response.Media.MediaType, MediaTypeTV)
+	}
+	if response.Media.Name == nil || *response.Media.Name != "Breaking Bad" || response.Person.ID != 17419 {
+		t.Errorf("GetCreditDetails() returned %+v", response)
+	}
+
+	episodes, seasons := response.Media.Episodes, response.Media.Seasons
+	if len(episodes) != 1 || episodes[0].ID != 62085 || episodes[0].Name != "Pilot" || episodes[0].ShowID != 1396 ||
+		episodes[0].AirDate != NewDate(2008, time.January, 20) {
+		t.Errorf("episodes are %+v", episodes)
+	}
+	if len(seasons) != 1 || seasons[0].ID != 3572 || seasons[0].EpisodeCount != 7 || seasons[0].SeasonNumber != 1 {
+		t.Errorf("seasons are %+v", seasons)
+	}
+}
@@ -0,0 +1,836 @@
+package gotmdbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type (
+	// V4Client is the TMDB API v4 client, which shares the credentials of the TMDB API client
+	V4Client struct {
+		client Client
+	}
+)
+
+// V4 returns the TMDB API v4 client
+//
+// Returns:
+//
+// - *V4Client: the TMDB API v4 client
+func (c Client) V4() *V4Client {
+	return &V4Client{
+		client: c,
+	}
+}
+
+// addAuthorizationToRequest authenticates the HTTP request with the user access token, or else with the read access
+// token of the credential provider, since the TMDB API v4 only accepts bearer tokens
+//
+// The requests with a user access token do not use the credential provider, so they are not reported to it.
+//
+// Parameters:
+//
+// - req: the HTTP request
+// - accessToken: the user access token (optional)
+//
+// Returns:
+//
+// - *http.Request: the HTTP request, whose context records the read access token used
+// - error: if the credential provider has no read access token available, or returned a v3 API key
+func (v V4Client) addAuthorizationToRequest(req *http.Request, accessToken string) (*http.Request, error) {
+	if accessToken != "" {
+		setCredential(req, accessToken, AuthBearer)
+		return req, nil
+	}
+
+	readAccessToken, err := v.client.credentialProvider().Credential(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	if v.client.resolveAuthMode(readAccessToken) == AuthAPIKey {
+		return nil, ErrV4RequiresReadAccessToken
+	}
+
+	req = req.WithContext(context.WithValue(req.Context(), credentialContextKey{}, readAccessToken))
+	setCredential(req, readAccessToken, AuthBearer)
+	return req, nil
+}
+
+// doRequest makes an HTTP request to the TMDB API v4 and parses the JSON response
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - endpoint: the endpoint name, used by the middlewares, hooks and logs
+// - method: the HTTP method
+// - apiURL: the TMDB API v4 URL
+// - accessToken: the user access token, used instead of the client API key when not empty (optional)
+// - query: the query parameters (optional)
+// - body: the value to be encoded as the JSON request body (optional)
+// - parsedResp: the pointer to the value where the response will be parsed into
+//
+// Returns:
+//
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error making the request or parsing the response
+func (v V4Client) doRequest(
+	ctx context.Context,
+	endpoint string,
+	method string,
+	apiURL string,
+	accessToken string,
+	query url.Values,
+	body any,
+	parsedResp any,
+) (int, error) {
+	// Create the HTTP request
+	req, err := buildRequest(ctx, endpoint, method, apiURL, body)
+	if err != nil {
+		return 0, err
+	}
+
+	// Add query parameters
+	if len(query) > 0 {
+		mergeQueryParameters(req, query)
+	}
+
+	// Make the HTTP request and parse the response
+	_, statusCode, err := v.client.sendRequest(
+		req, parsedResp, func(req *http.Request) (*http.Request, error) {
+			return v.addAuthorizationToRequest(req, accessToken)
+		},
+	)
+	return statusCode, err
+}
+
+// CreateRequestToken creates a new request token that must be approved by the user on the TMDB website
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - redirectTo: the URL the user will be redirected to after approving the request token (optional)
+//
+// Returns:
+//
+// - (*V4RequestTokenResponse): the response containing the request token
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error creating the request token
+func (v V4Client) CreateRequestToken(
+	ctx context.Context,
+	redirectTo string,
+) (parsedResp *V4RequestTokenResponse, statusCode int, err error) {
+	parsedResp = &V4RequestTokenResponse{}
+	if statusCode, err = v.doRequest(
+		ctx,
+		"V4CreateRequestToken",
+		http.MethodPost,
+		V4CreateRequestTokenURL,
+		"",
+		nil,
+		&V4RequestTokenRequest{RedirectTo: redirectTo},
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// CreateAccessToken creates a new user access token from an approved request token
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - requestToken: the approved request token
+//
+// Returns:
+//
+// - (*V4AccessTokenResponse): the response containing the access token and the account ID
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error creating the access token
+func (v V4Client) CreateAccessToken(
+	ctx context.Context,
+	requestToken string,
+) (parsedResp *V4AccessTokenResponse, statusCode int, err error) {
+	if requestToken == "" {
+		return nil, 0, ErrEmptyRequestToken
+	}
+
+	parsedResp = &V4AccessTokenResponse{}
+	if statusCode, err = v.doRequest(
+		ctx,
+		"V4CreateAccessToken",
+		http.MethodPost,
+		V4AccessTokenURL,
+		"",
+		nil,
+		&V4AccessTokenRequest{RequestToken: requestToken},
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// LogoutAccessToken deletes a user access token, logging the user out
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error deleting the access token
+func (v V4Client) LogoutAccessToken(
+	ctx context.Context,
+	accessToken string,
+) (parsedResp *StatusResponse, statusCode int, err error) {
+	if accessToken == "" {
+		return nil, 0, ErrEmptyAccessToken
+	}
+
+	parsedResp = &StatusResponse{}
+	if statusCode, err = v.doRequest(
+		ctx,
+		"V4LogoutAccessToken",
+		http.MethodDelete,
+		V4AccessTokenURL,
+		"",
+		nil,
+		&V4LogoutRequest{AccessToken: accessToken},
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetList fetches the details and the paginated items of a given list
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token, required for private lists (optional)
+// - listID: the ID of the list
+// - language: the language code (optional, defaults to the client default language)
+// - page: the page number (optional, defaults to 1)
+// - sortBy: the sort by value (optional, defaults to the list sort order)
+//
+// Returns:
+//
+// - (*V4ListDetailsResponse): the response containing the list details
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the list
+func (v V4Client) GetList(
+	ctx context.Context,
+	accessToken string,
+	listID int32,
+	language string,
+	page int32,
+	sortBy V4ListSortByEnum,
+) (parsedResp *V4ListDetailsResponse, statusCode int, err error) {
+	// Add query parameters, falling back to the default language
+	if language == "" {
+		language = v.client.resolveDefaults(ctx).Language
+	}
+	q := url.Values{}
+	AddLanguageQueryParameter(q, language)
+	AddPageQueryParameter(q, page)
+	if sortBy != "" {
+		q.Add(SortBy, string(sortBy))
+	}
+
+	apiURL := fmt.Sprintf(V4ListURL, fmt.Sprintf("%d", listID))
+	parsedResp = &V4ListDetailsResponse{}
+	if statusCode, err = v.doRequest(
+		ctx,
+		"V4GetList",
+		http.MethodGet,
+		apiURL,
+		accessToken,
+		q,
+		nil,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// CreateList creates a new list for the user of a given access token
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+// - body: the list to be created
+//
+// Returns:
+//
+// - (*V4CreateListResponse): the response containing the ID of the created list
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error creating the list
+func (v V4Client) CreateList(
+	ctx context.Context,
+	accessToken string,
+	body *V4CreateListRequest,
+) (parsedResp *V4CreateListResponse, statusCode int, err error) {
+	if accessToken == "" {
+		return nil, 0, ErrEmptyAccessToken
+	}
+	if body == nil {
+		return nil, 0, ErrNilRequestBody
+	}
+	if body.Name == "" {
+		return nil, 0, ErrEmptyListName
+	}
+
+	parsedResp = &V4CreateListResponse{}
+	if statusCode, err = v.doRequest(
+		ctx,
+		"V4CreateList",
+		http.MethodPost,
+		V4CreateListURL,
+		accessToken,
+		nil,
+		body,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// UpdateList updates the name, description, visibility or sort order of a given list
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+// - listID: the ID of the list
+// - body: the list fields to be updated
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error updating the list
+func (v V4Client) UpdateList(
+	ctx context.Context,
+	accessToken string,
+	listID int32,
+	body *V4UpdateListRequest,
+) (parsedResp *StatusResponse, statusCode int, err error) {
+	if accessToken == "" {
+		return nil, 0, ErrEmptyAccessToken
+	}
+	if body == nil {
+		return nil, 0, ErrNilRequestBody
+	}
+
+	apiURL := fmt.Sprintf(V4ListURL, fmt.Sprintf("%d", listID))
+	parsedResp = &StatusResponse{}
+	if statusCode, err = v.doRequest(
+		ctx,
+		"V4UpdateList",
+		http.MethodPut,
+		apiURL,
+		accessToken,
+		nil,
+		body,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// ClearList removes all the items from a given list
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+// - listID: the ID of the list
+//
+// Returns:
+//
+// - (*V4ClearListResponse): the response containing the number of deleted items
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error clearing the list
+func (v V4Client) ClearList(
+	ctx context.Context,
+	accessToken string,
+	listID int32,
+) (parsedResp *V4ClearListResponse, statusCode int, err error) {
+	if accessToken == "" {
+		return nil, 0, ErrEmptyAccessToken
+	}
+
+	apiURL := fmt.Sprintf(V4ClearListURL, fmt.Sprintf("%d", listID))
+	parsedResp = &V4ClearListResponse{}
+	if statusCode, err = v.doRequest(
+		ctx,
+		"V4ClearList",
+		http.MethodGet,
+		apiURL,
+		accessToken,
+		nil,
+		nil,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// DeleteList deletes a given list
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+// - listID: the ID of the list
+//
+// Returns:
+//
+// - (*StatusResponse): the response containing the status of the request
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error deleting the list
+func (v V4Client) DeleteList(
+	ctx context.Context,
+	accessToken string,
+	listID int32,
+) (parsedResp *StatusResponse, statusCode int, err error) {
+	if accessToken == "" {
+		return nil, 0, ErrEmptyAccessToken
+	}
+
+	apiURL := fmt.Sprintf(V4ListURL, fmt.Sprintf("%d", listID))
+	parsedResp = &StatusResponse{}
+	if statusCode, err = v.doRequest(
+		ctx,
+		"V4DeleteList",
+		http.MethodDelete,
+		apiURL,
+		accessToken,
+		nil,
+		nil,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// doListItemsRequest adds, updates or removes items from a given list in bulk
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - endpoint: the endpoint name, used by the middlewares, hooks and logs
+// - method: the HTTP method
+// - accessToken: the user access token
+// - listID: the ID of the list
+// - items: the items to be added, updated or removed
+//
+// Returns:
+//
+// - (*V4ListItemsResponse): the response containing the result of each item
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error making the request
+func (v V4Client) doListItemsRequest(
+	ctx context.Context,
+	endpoint string,
+	method string,
+	accessToken string,
+	listID int32,
+	items []V4ListItemRequest,
+) (parsedResp *V4ListItemsResponse, statusCode int, err error) {
+	if accessToken == "" {
+		return nil, 0, ErrEmptyAccessToken
+	}
+	if len(items) == 0 {
+		return nil, 0, ErrEmptyListItems
+	}
+	for _, item := range items {
+		if err = validateMediaType(item.MediaType); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	apiURL := fmt.Sprintf(V4ListItemsURL, fmt.Sprintf("%d", listID))
+	parsedResp = &V4ListItemsResponse{}
+	if statusCode, err = v.doRequest(
+		ctx,
+		endpoint,
+		method,
+		apiURL,
+		accessToken,
+		nil,
+		&V4ListItemsRequest{Items: items},
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// AddItems adds movies or TV shows to a given list in bulk
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+// - listID: the ID of the list
+// - items: the items to be added, optionally with a comment
+//
+// Returns:
+//
+// - (*V4ListItemsResponse): the response containing the result of each item
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error adding the items
+func (v V4Client) AddItems(
+	ctx context.Context,
+	accessToken string,
+	listID int32,
+	items []V4ListItemRequest,
+) (*V4ListItemsResponse, int, error) {
+	return v.doListItemsRequest(ctx, "V4AddItems", http.MethodPost, accessToken, listID, items)
+}
+
+// UpdateItems updates the comments of the items of a given list in bulk
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+// - listID: the ID of the list
+// - items: the items to be updated with their new comment
+//
+// Returns:
+//
+// - (*V4ListItemsResponse): the response containing the result of each item
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error updating the items
+func (v V4Client) UpdateItems(
+	ctx context.Context,
+	accessToken string,
+	listID int32,
+	items []V4ListItemRequest,
+) (*V4ListItemsResponse, int, error) {
+	return v.doListItemsRequest(ctx, "V4UpdateItems", http.MethodPut, accessToken, listID, items)
+}
+
+// RemoveItems removes movies or TV shows from a given list in bulk
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+// - listID: the ID of the list
+// - items: the items to be removed
+//
+// Returns:
+//
+// - (*V4ListItemsResponse): the response containing the result of each item
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error removing the items
+func (v V4Client) RemoveItems(
+	ctx context.Context,
+	accessToken string,
+	listID int32,
+	items []V4ListItemRequest,
+) (*V4ListItemsResponse, int, error) {
+	return v.doListItemsRequest(ctx, "V4RemoveItems", http.MethodDelete, accessToken, listID, items)
+}
+
+// CheckItemStatus checks if a movie or TV show is present in a given list
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+// - listID: the ID of the list
+// - mediaType: the media type of the item
+// - mediaID: the ID of the item
+//
+// Returns:
+//
+// - (*V4ListItemStatusResponse): the response containing the status of the item
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error checking the item status
+func (v V4Client) CheckItemStatus(
+	ctx context.Context,
+	accessToken string,
+	listID int32,
+	mediaType MediaTypeEnum,
+	mediaID int32,
+) (parsedResp *V4ListItemStatusResponse, statusCode int, err error) {
+	if accessToken == "" {
+		return nil, 0, ErrEmptyAccessToken
+	}
+	if err = validateMediaType(mediaType); err != nil {
+		return nil, 0, err
+	}
+
+	// Add query parameters
+	q := url.Values{}
+	q.Add(MediaID, fmt.Sprintf("%d", mediaID))
+	q.Add(MediaType, string(mediaType))
+
+	apiURL := fmt.Sprintf(V4ListItemStatusURL, fmt.Sprintf("%d", listID))
+	parsedResp = &V4ListItemStatusResponse{}
+	if statusCode, err = v.doRequest(
+		ctx,
+		"V4CheckItemStatus",
+		http.MethodGet,
+		apiURL,
+		accessToken,
+		q,
+		nil,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// getAccountList fetches a paginated list of items of a given v4 account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - endpoint: the endpoint name, used by the middlewares, hooks and logs
+// - accessToken: the user access token
+// - apiURL: the TMDB API v4 URL format, with a placeholder for the account ID
+// - accountID: the v4 account object ID
+// - page: the page number (optional, defaults to 1)
+// - sortBy: the sort by value (optional)
+// - parsedResp: the pointer to the value where the response will be parsed into
+//
+// Returns:
+//
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the account list
+func (v V4Client) getAccountList(
+	ctx context.Context,
+	endpoint string,
+	accessToken string,
+	apiURL string,
+	accountID string,
+	page int32,
+	sortBy AccountSortByEnum,
+	parsedResp any,
+) (int, error) {
+	if accessToken == "" {
+		return 0, ErrEmptyAccessToken
+	}
+	if accountID == "" {
+		return 0, ErrEmptyAccountID
+	}
+
+	// Add query parameters
+	q := url.Values{}
+	AddPageQueryParameter(q, page)
+	AddAccountSortByQueryParameter(q, sortBy)
+
+	return v.doRequest(
+		ctx,
+		endpoint,
+		http.MethodGet,
+		fmt.Sprintf(apiURL, url.PathEscape(accountID)),
+		accessToken,
+		q,
+		nil,
+		parsedResp,
+	)
+}
+
+// GetAccountLists fetches the lists created by a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+// - accountID: the v4 account object ID
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*V4AccountListsResponse): the response containing the account lists
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the account lists
+func (v V4Client) GetAccountLists(
+	ctx context.Context,
+	accessToken string,
+	accountID string,
+	page int32,
+) (parsedResp *V4AccountListsResponse, statusCode int, err error) {
+	parsedResp = &V4AccountListsResponse{}
+	if statusCode, err = v.getAccountList(
+		ctx,
+		"V4GetAccountLists",
+		accessToken,
+		V4AccountListsURL,
+		accountID,
+		page,
+		"",
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetAccountFavoriteMovies fetches the favorite movies of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+// - accountID: the v4 account object ID
+// - page: the page number (optional, defaults to 1)
+// - sortBy: the sort by value (optional)
+//
+// Returns:
+//
+// - (*MovieListResponse): the response containing the favorite movies
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the favorite movies
+func (v V4Client) GetAccountFavoriteMovies(
+	ctx context.Context,
+	accessToken string,
+	accountID string,
+	page int32,
+	sortBy AccountSortByEnum,
+) (parsedResp *MovieListResponse, statusCode int, err error) {
+	parsedResp = &MovieListResponse{}
+	if statusCode, err = v.getAccountList(
+		ctx,
+		"V4GetAccountFavoriteMovies",
+		accessToken,
+		V4AccountFavoriteMoviesURL,
+		accountID,
+		page,
+		sortBy,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetAccountFavoriteTV fetches the favorite TV shows of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+// - accountID: the v4 account object ID
+// - page: the page number (optional, defaults to 1)
+// - sortBy: the sort by value (optional)
+//
+// Returns:
+//
+// - (*TVListResponse): the response containing the favorite TV shows
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the favorite TV shows
+func (v V4Client) GetAccountFavoriteTV(
+	ctx context.Context,
+	accessToken string,
+	accountID string,
+	page int32,
+	sortBy AccountSortByEnum,
+) (parsedResp *TVListResponse, statusCode int, err error) {
+	parsedResp = &TVListResponse{}
+	if statusCode, err = v.getAccountList(
+		ctx,
+		"V4GetAccountFavoriteTV",
+		accessToken,
+		V4AccountFavoriteTVURL,
+		accountID,
+		page,
+		sortBy,
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetAccountMovieRecommendations fetches the movie recommendations of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+// - accountID: the v4 account object ID
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*MovieListResponse): the response containing the recommended movies
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the movie recommendations
+func (v V4Client) GetAccountMovieRecommendations(
+	ctx context.Context,
+	accessToken string,
+	accountID string,
+	page int32,
+) (parsedResp *MovieListResponse, statusCode int, err error) {
+	parsedResp = &MovieListResponse{}
+	if statusCode, err = v.getAccountList(
+		ctx,
+		"V4GetAccountMovieRecommendations",
+		accessToken,
+		V4AccountMovieRecommendationsURL,
+		accountID,
+		page,
+		"",
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}
+
+// GetAccountTVRecommendations fetches the TV show recommendations of a given account
+//
+// Parameters:
+//
+// - ctx: the context of the request
+// - accessToken: the user access token
+// - accountID: the v4 account object ID
+// - page: the page number (optional, defaults to 1)
+//
+// Returns:
+//
+// - (*TVListResponse): the response containing the recommended TV shows
+// - int: the HTTP status code, or 0 if no response was received
+// - error: if there was an error fetching the TV show recommendations
+func (v V4Client) GetAccountTVRecommendations(
+	ctx context.Context,
+	accessToken string,
+	accountID string,
+	page int32,
+) (parsedResp *TVListResponse, statusCode int, err error) {
+	parsedResp = &TVListResponse{}
+	if statusCode, err = v.getAccountList(
+		ctx,
+		"V4GetAccountTVRecommendations",
+		accessToken,
+		V4AccountTVRecommendationsURL,
+		accountID,
+		page,
+		"",
+		parsedResp,
+	); err != nil {
+		return nil, statusCode, err
+	}
+	return parsedResp, statusCode, nil
+}